@@ -0,0 +1,85 @@
+package main
+
+import (
+	"time"
+
+	cli "github.com/jawher/mow.cli"
+	"github.com/pkg/errors"
+	"github.com/xlab/closer"
+
+	"github.com/InjectiveLabs/peggo/orchestrator/alerts"
+)
+
+type alertOptions struct {
+	webhookURL   *string
+	slackURL     *string
+	pagerDutyKey *string
+	command      *string
+	minSeverity  *string
+	dedupWindow  *string
+	rateLimit    *int
+}
+
+// initAlertingOptions registers the alert options, they must be known
+// before the command line is parsed.
+func initAlertingOptions(c *cli.Cmd) *alertOptions {
+	opts := &alertOptions{}
+
+	initAlertOptions(
+		c,
+		&opts.webhookURL,
+		&opts.slackURL,
+		&opts.pagerDutyKey,
+		&opts.command,
+		&opts.minSeverity,
+		&opts.dedupWindow,
+		&opts.rateLimit,
+	)
+
+	return opts
+}
+
+// initAlerts sets up the configured alert sinks, alerts are only logged if there are none.
+func initAlerts(opts *alertOptions) error {
+	var sinks []alerts.Sink
+
+	if *opts.webhookURL != "" {
+		sinks = append(sinks, &alerts.WebhookSink{URL: *opts.webhookURL})
+	}
+
+	if *opts.slackURL != "" {
+		sinks = append(sinks, &alerts.SlackSink{URL: *opts.slackURL})
+	}
+
+	if *opts.pagerDutyKey != "" {
+		sinks = append(sinks, &alerts.PagerDutySink{RoutingKey: *opts.pagerDutyKey})
+	}
+
+	if *opts.command != "" {
+		sinks = append(sinks, &alerts.CommandSink{Command: *opts.command})
+	}
+
+	if len(sinks) == 0 {
+		return nil
+	}
+
+	minSeverity, err := alerts.ParseSeverity(*opts.minSeverity)
+	if err != nil {
+		return errors.Wrap(err, "invalid --alert-min-severity")
+	}
+
+	dedupWindow, err := time.ParseDuration(*opts.dedupWindow)
+	if err != nil {
+		return errors.Wrap(err, "invalid --alert-dedup-window")
+	}
+
+	alerts.Init(alerts.NewAlerter(alerts.Config{
+		MinSeverity: minSeverity,
+		DedupWindow: dedupWindow,
+		RateLimit:   *opts.rateLimit,
+	}, sinks...))
+
+	closer.Bind(alerts.Close)
+
+	return nil
+}
@@ -0,0 +1,647 @@
+package main
+
+import (
+	"context"
+	"io"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	cli "github.com/jawher/mow.cli"
+	"github.com/pkg/errors"
+	"github.com/xlab/closer"
+	log "github.com/xlab/suplog"
+
+	"github.com/InjectiveLabs/peggo/orchestrator/cosmos"
+	"github.com/InjectiveLabs/peggo/orchestrator/ethereum"
+	"github.com/InjectiveLabs/peggo/orchestrator/ethereum/peggy"
+	wrappers "github.com/InjectiveLabs/peggo/solidity/wrappers/Peggy.sol"
+)
+
+// contractCmdSubset contains the admin actions of the Peggy contract owner.
+//
+// $ peggo contract
+func contractCmdSubset(cmd *cli.Cmd) {
+	cmd.Command("deploy", "Deploys a Peggy contract behind an upgradeable proxy, initialized with the current Injective valset", contractDeployCmd)
+	cmd.Command("status", "Shows the owner, paused state and power threshold of the Peggy contract", contractStatusCmd)
+	cmd.Command("pause", "Pauses deposits and relaying on the Peggy contract", contractPauseCmd)
+	cmd.Command("unpause", "Resumes a paused Peggy contract", contractUnpauseCmd)
+	cmd.Command("deploy-erc20", "Deploys an ERC20 token for an Injective denom through the Peggy contract", contractDeployERC20Cmd)
+	cmd.Command("transfer-ownership", "Transfers the ownership of the Peggy contract", contractTransferOwnershipCmd)
+	cmd.Command("renounce-ownership", "Renounces the ownership of the Peggy contract, leaving it without an owner", contractRenounceOwnershipCmd)
+}
+
+// deployResult is the outcome of contract deploy.
+type deployResult struct {
+	PeggyContract       string   `json:"peggy_contract"`
+	PeggyImplementation string   `json:"peggy_implementation"`
+	ProxyAdmin          string   `json:"proxy_admin"`
+	CosmosTokenContract string   `json:"cosmos_token_contract,omitempty"`
+	Checkpoint          string   `json:"checkpoint"`
+	TxHashes            []string `json:"tx_hashes"`
+}
+
+func contractDeployCmd(cmd *cli.Cmd) {
+	txOpts := initEthSignerOptions(cmd)
+	dryRun := initDryRunOption(cmd)
+
+	var output *string
+	initOutputOptions(cmd, &output)
+
+	powerThreshold := cmd.String(cli.StringOpt{
+		Name:  "power-threshold",
+		Desc:  "Valset power needed to approve operations, out of the normalized total of 4294967295",
+		Value: "2834678415",
+	})
+
+	deployCosmosToken := cmd.Bool(cli.BoolOpt{
+		Name:  "cosmos-token",
+		Desc:  "Also deploy the ERC20 of the Cosmos coin, with the whole supply held by Peggy",
+		Value: false,
+	})
+
+	cosmosTokenName := cmd.String(cli.StringOpt{
+		Name:  "cosmos-token-name",
+		Desc:  "Name of the Cosmos coin ERC20",
+		Value: "Injective",
+	})
+
+	cosmosTokenSymbol := cmd.String(cli.StringOpt{
+		Name:  "cosmos-token-symbol",
+		Desc:  "Symbol of the Cosmos coin ERC20",
+		Value: "INJ",
+	})
+
+	cosmosTokenDecimals := cmd.Int(cli.IntOpt{
+		Name:  "cosmos-token-decimals",
+		Desc:  "Decimals of the Cosmos coin ERC20",
+		Value: 18,
+	})
+
+	cmd.Action = func() {
+		// ensure a clean exit
+		defer closer.Close()
+
+		threshold, ok := new(big.Int).SetString(*powerThreshold, 10)
+		if !ok || threshold.Sign() <= 0 {
+			log.WithField("power_threshold", *powerThreshold).Fatalln("power threshold must be a positive integer")
+		}
+
+		if *cosmosTokenDecimals < 0 || *cosmosTokenDecimals > 255 {
+			log.WithField("decimals", *cosmosTokenDecimals).Fatalln("invalid Cosmos token decimals")
+		}
+
+		ctx, cancelFn := context.WithTimeout(context.Background(), 30*time.Minute)
+		defer cancelFn()
+
+		peggyQuery, err := dialPeggyQueryClient(ctx, *txOpts.cosmosGRPC)
+		if err != nil {
+			log.WithError(err).Fatalln("failed to connect to Injective")
+		}
+
+		peggyParams, err := peggyQuery.PeggyParams(ctx)
+		if err != nil {
+			log.WithError(err).Fatalln("failed to query peggy params")
+		}
+
+		valset, err := peggyQuery.CurrentValset(ctx)
+		if err != nil {
+			log.WithError(err).Fatalln("failed to get current valset")
+		}
+
+		// peggyID is stored in the contract as a string right-padded to bytes32
+		if len(peggyParams.PeggyId) == 0 || len(peggyParams.PeggyId) > ethcmn.HashLength {
+			log.WithField("peggy_id", peggyParams.PeggyId).Fatalln("peggyID must be 1 to 32 bytes long")
+		}
+
+		var peggyID ethcmn.Hash
+		copy(peggyID[:], peggyParams.PeggyId)
+
+		totalPower := new(big.Int)
+		for _, m := range valset.Members {
+			totalPower.Add(totalPower, new(big.Int).SetUint64(m.Power))
+		}
+
+		if totalPower.Cmp(threshold) <= 0 {
+			log.WithFields(log.Fields{
+				"total_power":     totalPower.String(),
+				"power_threshold": threshold.String(),
+			}).Fatalln("valset power doesn't exceed the power threshold")
+		}
+
+		cfg := peggy.DeployConfig{
+			PeggyID:        peggyID,
+			PowerThreshold: threshold,
+			Valset:         valset,
+		}
+
+		if *deployCosmosToken {
+			cfg.CosmosToken = &peggy.CosmosTokenConfig{
+				Name:     *cosmosTokenName,
+				Symbol:   *cosmosTokenSymbol,
+				Decimals: uint8(*cosmosTokenDecimals),
+			}
+		}
+
+		ethKeyFromAddress, signerFn, err := txOpts.ethAccount()
+		if err != nil {
+			log.WithError(err).Fatalln("failed to init Ethereum account")
+		}
+
+		// the network isn't bound to a Peggy contract yet, it's used for the provider and gas price
+		ethNetwork, err := txOpts.connectEthereum(ethcmn.Address{}, ethKeyFromAddress, signerFn)
+		if err != nil {
+			log.WithError(err).Fatalln("failed to connect to Ethereum")
+		}
+
+		gasPrice, err := ethNetwork.GasPrice(ctx)
+		if err != nil {
+			log.WithError(err).Fatalln("failed to get gas price")
+		}
+
+		log.WithFields(log.Fields{
+			"from":            ethKeyFromAddress.Hex(),
+			"peggy_id":        peggyParams.PeggyId,
+			"power_threshold": threshold.String(),
+			"valset_nonce":    valset.Nonce,
+			"members":         len(valset.Members),
+			"total_power":     totalPower.String(),
+			"cosmos_token":    *deployCosmosToken,
+			"gas_price":       gasPrice.String(),
+		}).Infoln("Peggy deployment summary")
+
+		if *dryRun {
+			return
+		}
+
+		actionConfirmed := *txOpts.alwaysAutoConfirm || stdinConfirm("Confirm Peggy deployment? [y/N]: ")
+		if !actionConfirmed {
+			return
+		}
+
+		auth := &bind.TransactOpts{
+			From:     ethKeyFromAddress,
+			Signer:   signerFn,
+			GasPrice: gasPrice,
+		}
+
+		result, err := peggy.DeployPeggyContract(ctx, ethNetwork.Provider(), auth, cfg)
+		if result != nil {
+			for _, txHash := range result.TxHashes {
+				log.WithField("tx_hash", txHash.Hex()).Infoln("Deployment tx sent")
+			}
+		}
+
+		if err != nil {
+			log.WithError(err).Fatalln("Peggy deployment failed")
+		}
+
+		out := deployResult{
+			PeggyContract:       result.PeggyContract.Hex(),
+			PeggyImplementation: result.PeggyImplementation.Hex(),
+			ProxyAdmin:          result.ProxyAdmin.Hex(),
+			Checkpoint:          result.Checkpoint.Hex(),
+		}
+
+		if cfg.CosmosToken != nil {
+			out.CosmosTokenContract = result.CosmosTokenContract.Hex()
+		}
+
+		for _, txHash := range result.TxHashes {
+			out.TxHashes = append(out.TxHashes, txHash.Hex())
+		}
+
+		err = printOutput(*output, out, func(w io.Writer) {
+			printRow(w, "peggy_contract", out.PeggyContract)
+			if len(out.CosmosTokenContract) > 0 {
+				printRow(w, "cosmos_token_contract", out.CosmosTokenContract)
+			}
+			printRow(w, "checkpoint", out.Checkpoint)
+		})
+		if err != nil {
+			log.WithError(err).Fatalln("failed to print the result")
+		}
+	}
+}
+
+// peggyStatus is the admin state of the Peggy contract.
+type peggyStatus struct {
+	Address          string `json:"address"`
+	Owner            string `json:"owner"`
+	Paused           bool   `json:"paused"`
+	OwnershipExpiry  string `json:"ownership_expiry"`
+	OwnershipExpired bool   `json:"ownership_expired"`
+	PowerThreshold   string `json:"power_threshold"`
+	ValsetNonce      uint64 `json:"valset_nonce"`
+}
+
+func contractStatusCmd(cmd *cli.Cmd) {
+	var cosmosGRPC, output *string
+	initQueryOptions(cmd, &cosmosGRPC, &output)
+
+	ethNodeRPC := initEthereumRPCOption(cmd)
+
+	peggyContract := cmd.String(cli.StringOpt{
+		Name:   "peggy-contract",
+		Desc:   "Address of the Peggy contract, read from the Peggy module params if not set",
+		EnvVar: "PEGGO_PEGGY_CONTRACT",
+	})
+
+	cmd.Action = func() {
+		runQuery(*cosmosGRPC, func(ctx context.Context, peggyQuery cosmos.PeggyQueryClient) error {
+			peggyAddr, err := resolvePeggyContract(ctx, peggyQuery, *peggyContract)
+			if err != nil {
+				return err
+			}
+
+			peggyReader, err := dialPeggyReader(ctx, *ethNodeRPC, peggyAddr)
+			if err != nil {
+				return err
+			}
+
+			status, err := getPeggyStatus(ctx, peggyReader)
+			if err != nil {
+				return err
+			}
+
+			return printOutput(*output, status, func(w io.Writer) {
+				printRow(w, "address", status.Address)
+				printRow(w, "owner", status.Owner)
+				printRow(w, "paused", status.Paused)
+				printRow(w, "ownership_expiry", status.OwnershipExpiry)
+				printRow(w, "ownership_expired", status.OwnershipExpired)
+				printRow(w, "power_threshold", status.PowerThreshold)
+				printRow(w, "valset_nonce", status.ValsetNonce)
+			})
+		})
+	}
+}
+
+func contractPauseCmd(cmd *cli.Cmd) {
+	txOpts := initEthTxOptions(cmd)
+	dryRun := initDryRunOption(cmd)
+
+	cmd.Action = func() {
+		runPeggyAdminCall(txOpts, *dryRun, func(status *peggyStatus) (*peggyAdminCall, error) {
+			if status.Paused {
+				return nil, errors.New("Peggy contract is already paused")
+			}
+
+			return &peggyAdminCall{
+				name:   "EmergencyPause",
+				method: "emergencyPause",
+			}, nil
+		})
+	}
+}
+
+func contractUnpauseCmd(cmd *cli.Cmd) {
+	txOpts := initEthTxOptions(cmd)
+	dryRun := initDryRunOption(cmd)
+
+	cmd.Action = func() {
+		runPeggyAdminCall(txOpts, *dryRun, func(status *peggyStatus) (*peggyAdminCall, error) {
+			if !status.Paused {
+				return nil, errors.New("Peggy contract is not paused")
+			}
+
+			return &peggyAdminCall{
+				name:   "EmergencyUnpause",
+				method: "emergencyUnpause",
+			}, nil
+		})
+	}
+}
+
+func contractDeployERC20Cmd(cmd *cli.Cmd) {
+	txOpts := initEthTxOptions(cmd)
+	dryRun := initDryRunOption(cmd)
+
+	denom := cmd.String(cli.StringOpt{
+		Name: "denom",
+		Desc: "Injective denom the token represents",
+	})
+
+	name := cmd.String(cli.StringOpt{
+		Name: "name",
+		Desc: "Name of the token",
+	})
+
+	symbol := cmd.String(cli.StringOpt{
+		Name: "symbol",
+		Desc: "Symbol of the token",
+	})
+
+	decimals := cmd.Int(cli.IntOpt{
+		Name:  "decimals",
+		Desc:  "Decimals of the token",
+		Value: 18,
+	})
+
+	cmd.Action = func() {
+		runPeggyAdminCall(txOpts, *dryRun, func(status *peggyStatus) (*peggyAdminCall, error) {
+			if len(*denom) == 0 || len(*name) == 0 || len(*symbol) == 0 {
+				return nil, errors.New("denom, name and symbol must be set")
+			} else if *decimals < 0 || *decimals > 255 {
+				return nil, errors.Errorf("invalid decimals %d", *decimals)
+			}
+
+			return &peggyAdminCall{
+				name:   "DeployERC20",
+				method: "deployERC20",
+				args:   []interface{}{*denom, *name, *symbol, uint8(*decimals)},
+				summary: log.Fields{
+					"denom":    *denom,
+					"name":     *name,
+					"symbol":   *symbol,
+					"decimals": *decimals,
+				},
+				onReceipt: logDeployedERC20,
+			}, nil
+		})
+	}
+}
+
+func contractTransferOwnershipCmd(cmd *cli.Cmd) {
+	txOpts := initEthTxOptions(cmd)
+	dryRun := initDryRunOption(cmd)
+
+	newOwner := cmd.String(cli.StringOpt{
+		Name: "new-owner",
+		Desc: "Ethereum address of the new owner, e.g. a multisig",
+	})
+
+	cmd.Action = func() {
+		runPeggyAdminCall(txOpts, *dryRun, func(status *peggyStatus) (*peggyAdminCall, error) {
+			if !ethcmn.IsHexAddress(*newOwner) {
+				return nil, errors.Errorf("invalid new owner address: %s", *newOwner)
+			}
+
+			newOwnerAddr := ethcmn.HexToAddress(*newOwner)
+			if newOwnerAddr == (ethcmn.Address{}) {
+				return nil, errors.New("new owner must not be the zero address, use renounce-ownership instead")
+			}
+
+			return &peggyAdminCall{
+				name:   "TransferOwnership",
+				method: "transferOwnership",
+				args:   []interface{}{newOwnerAddr},
+				summary: log.Fields{
+					"new_owner": newOwnerAddr.Hex(),
+				},
+				irreversible: true,
+			}, nil
+		})
+	}
+}
+
+func contractRenounceOwnershipCmd(cmd *cli.Cmd) {
+	txOpts := initEthTxOptions(cmd)
+	dryRun := initDryRunOption(cmd)
+
+	afterExpiry := cmd.Bool(cli.BoolOpt{
+		Name:  "after-expiry",
+		Desc:  "Use renounceOwnershipAfterExpiry, which anyone can call once the ownership has expired",
+		Value: false,
+	})
+
+	cmd.Action = func() {
+		runPeggyAdminCall(txOpts, *dryRun, func(status *peggyStatus) (*peggyAdminCall, error) {
+			if status.Owner == (ethcmn.Address{}).Hex() {
+				return nil, errors.New("Peggy contract has no owner")
+			}
+
+			call := &peggyAdminCall{
+				name:         "RenounceOwnership",
+				method:       "renounceOwnership",
+				irreversible: true,
+			}
+
+			if *afterExpiry {
+				if !status.OwnershipExpired {
+					return nil, errors.Errorf("ownership expires at %s", status.OwnershipExpiry)
+				}
+
+				call.name = "RenounceOwnershipAfterExpiry"
+				call.method = "renounceOwnershipAfterExpiry"
+			}
+
+			return call, nil
+		})
+	}
+}
+
+func initDryRunOption(cmd *cli.Cmd) *bool {
+	return cmd.Bool(cli.BoolOpt{
+		Name:  "dry-run",
+		Desc:  "Only simulate the call and print its summary, without sending the tx",
+		Value: false,
+	})
+}
+
+// peggyAdminCall is a call of an admin method of the Peggy contract.
+type peggyAdminCall struct {
+	// name is shown to the user, method is the name in the contract ABI
+	name   string
+	method string
+	args   []interface{}
+
+	summary log.Fields
+
+	// irreversible calls must be confirmed by typing the Peggy contract address
+	irreversible bool
+
+	onReceipt func(ethNetwork *ethereum.Network, receipt *ethtypes.Receipt)
+}
+
+// runPeggyAdminCall builds the call from the current contract status, simulates it
+// and, once confirmed, sends it from the Ethereum key and waits until it's mined.
+func runPeggyAdminCall(
+	txOpts *ethTxOptions,
+	dryRun bool,
+	buildCall func(status *peggyStatus) (*peggyAdminCall, error),
+) {
+	// ensure a clean exit
+	defer closer.Close()
+
+	ctx, cancelFn := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancelFn()
+
+	peggyQuery, err := dialPeggyQueryClient(ctx, *txOpts.cosmosGRPC)
+	if err != nil {
+		log.WithError(err).Fatalln("failed to connect to Injective")
+	}
+
+	peggyContractAddr, err := resolvePeggyContract(ctx, peggyQuery, *txOpts.peggyContract)
+	if err != nil {
+		log.WithError(err).Fatalln("failed to resolve Peggy contract")
+	}
+
+	ethClient, err := ethclient.DialContext(ctx, *txOpts.ethNodeRPC)
+	if err != nil {
+		log.WithError(err).Fatalln("failed to connect to Ethereum")
+	}
+
+	peggyReader, err := newPeggyReader(peggyContractAddr, ethClient)
+	if err != nil {
+		log.WithError(err).Fatalln("failed to init Peggy caller")
+	}
+
+	status, err := getPeggyStatus(ctx, peggyReader)
+	if err != nil {
+		log.WithError(err).Fatalln("failed to get Peggy contract status")
+	}
+
+	call, err := buildCall(status)
+	if err != nil {
+		log.WithError(err).Fatalln("invalid call")
+	}
+
+	txData, err := packPeggyCall(call.method, call.args...)
+	if err != nil {
+		log.WithError(err).Fatalln("failed to encode the call")
+	}
+
+	summary := log.Fields{
+		"owner":  status.Owner,
+		"paused": status.Paused,
+	}
+	for k, v := range call.summary {
+		summary[k] = v
+	}
+
+	if dryRun {
+		// no key is loaded, the call is simulated from the owner, whose
+		// calldata can be submitted from elsewhere, e.g. a multisig
+		summary["calldata"] = hexutil.Encode(txData)
+		confirmPeggyCall(ctx, ethClient, peggyContractAddr, ethcmn.HexToAddress(status.Owner), call.name, txData, summary, true, false)
+		return
+	}
+
+	ethKeyFromAddress, signerFn, err := txOpts.ethAccount()
+	if err != nil {
+		log.WithError(err).Fatalln("failed to load the Ethereum key")
+	}
+
+	ethNetwork, err := txOpts.connectEthereum(peggyContractAddr, ethKeyFromAddress, signerFn)
+	if err != nil {
+		log.WithError(err).Fatalln("failed to connect to Ethereum")
+	}
+
+	if !confirmPeggyCall(ctx, ethClient, peggyContractAddr, ethKeyFromAddress, call.name, txData, summary, false, *txOpts.alwaysAutoConfirm) {
+		return
+	}
+
+	if call.irreversible && !*txOpts.alwaysAutoConfirm {
+		if !stdinConfirmValue("This can't be undone. Type the Peggy contract address to continue: ", peggyContractAddr.Hex()) {
+			log.Infoln("Aborted")
+			return
+		}
+	}
+
+	txHash, err := ethNetwork.SendTx(ctx, ethNetwork.Address(), txData)
+	if err != nil {
+		log.WithError(err).Fatalf("failed to send %s tx", call.name)
+	}
+
+	receipt, err := waitForReceipt(ctx, ethNetwork.Provider(), txHash)
+	if err != nil {
+		log.WithError(err).WithField("tx_hash", txHash.Hex()).Fatalf("%s tx failed", call.name)
+	}
+
+	log.WithFields(log.Fields{
+		"tx_hash":  txHash.Hex(),
+		"block":    receipt.BlockNumber,
+		"gas_used": receipt.GasUsed,
+	}).Infof("%s tx mined", call.name)
+
+	if call.onReceipt != nil {
+		call.onReceipt(ethNetwork, receipt)
+	}
+}
+
+func packPeggyCall(method string, args ...interface{}) ([]byte, error) {
+	peggyABI, err := abi.JSON(strings.NewReader(wrappers.PeggyABI))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse Peggy ABI")
+	}
+
+	return peggyABI.Pack(method, args...)
+}
+
+func getPeggyStatus(ctx context.Context, peggyReader *peggyReader) (*peggyStatus, error) {
+	peggyCaller := peggyReader.caller
+	callOpts := &bind.CallOpts{Context: ctx}
+
+	owner, err := peggyCaller.Owner(callOpts)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get owner")
+	}
+
+	paused, err := peggyCaller.Paused(callOpts)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get paused state")
+	}
+
+	expiry, err := peggyCaller.GetOwnershipExpiryTimestamp(callOpts)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get ownership expiry")
+	}
+
+	expired, err := peggyCaller.IsOwnershipExpired(callOpts)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get ownership expiry")
+	}
+
+	powerThreshold, err := peggyCaller.StatePowerThreshold(callOpts)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get power threshold")
+	}
+
+	valsetNonce, err := peggyReader.GetValsetNonce(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get valset nonce")
+	}
+
+	return &peggyStatus{
+		Address:          peggyReader.Address().Hex(),
+		Owner:            owner.Hex(),
+		Paused:           paused,
+		OwnershipExpiry:  time.Unix(expiry.Int64(), 0).UTC().Format(time.RFC3339),
+		OwnershipExpired: expired,
+		PowerThreshold:   powerThreshold.String(),
+		ValsetNonce:      valsetNonce.Uint64(),
+	}, nil
+}
+
+// logDeployedERC20 logs the token deployed by a DeployERC20 tx.
+func logDeployedERC20(ethNetwork *ethereum.Network, receipt *ethtypes.Receipt) {
+	peggyFilterer, err := wrappers.NewPeggyFilterer(ethNetwork.Address(), ethNetwork.Provider())
+	if err != nil {
+		log.WithError(err).Warningln("failed to init Peggy events filterer")
+		return
+	}
+
+	for _, eventLog := range receipt.Logs {
+		if eventLog.Address != ethNetwork.Address() {
+			continue
+		}
+
+		if event, err := peggyFilterer.ParseERC20DeployedEvent(*eventLog); err == nil {
+			log.WithFields(log.Fields{
+				"denom":       event.CosmosDenom,
+				"token":       event.TokenContract.Hex(),
+				"event_nonce": event.EventNonce.Uint64(),
+			}).Infoln("ERC20 token deployed")
+			return
+		}
+	}
+
+	log.Warningln("no ERC20DeployedEvent in tx receipt")
+}
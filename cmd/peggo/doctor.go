@@ -0,0 +1,435 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"time"
+
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	cosmtypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	cli "github.com/jawher/mow.cli"
+	"github.com/shopspring/decimal"
+
+	"github.com/InjectiveLabs/sdk-go/chain/peggy/types"
+
+	"github.com/InjectiveLabs/peggo/orchestrator/cosmos"
+	"github.com/InjectiveLabs/peggo/orchestrator/ethereum/util"
+	wrappers "github.com/InjectiveLabs/peggo/solidity/wrappers/Peggy.sol"
+)
+
+// doctorCheckTimeout bounds every network call made by a single check.
+const doctorCheckTimeout = 15 * time.Second
+
+type checkStatus string
+
+const (
+	checkPass checkStatus = "PASS"
+	checkWarn checkStatus = "WARN"
+	checkFail checkStatus = "FAIL"
+)
+
+type checkResult struct {
+	Name   string      `json:"name"`
+	Status checkStatus `json:"status"`
+	Detail string      `json:"detail"`
+}
+
+// doctorReport collects the results of the preflight checks.
+type doctorReport struct {
+	Checks []checkResult `json:"checks"`
+}
+
+func (r *doctorReport) add(name string, status checkStatus, format string, args ...interface{}) {
+	r.Checks = append(r.Checks, checkResult{
+		Name:   name,
+		Status: status,
+		Detail: fmt.Sprintf(format, args...),
+	})
+}
+
+func (r *doctorReport) failed() bool {
+	for _, check := range r.Checks {
+		if check.Status == checkFail {
+			return true
+		}
+	}
+
+	return false
+}
+
+// doctorKeys are the accounts loaded from the configured keys.
+type doctorKeys struct {
+	valAddress   cosmtypes.AccAddress
+	ethAddr      ethcmn.Address
+	relayerAddrs []ethcmn.Address
+}
+
+// doctorCmd checks the orchestrator configuration against the live networks
+// before the orchestrator is started. It accepts the same options as the orchestrator.
+//
+// $ peggo doctor
+func doctorCmd(cmd *cli.Cmd) {
+	cfg := initConfig(cmd)
+
+	var output *string
+	initOutputOptions(cmd, &output)
+
+	cmd.Action = func() {
+		report := &doctorReport{}
+		runDoctor(cfg, report)
+
+		err := printOutput(*output, report, func(w io.Writer) {
+			printRow(w, "STATUS", "CHECK", "DETAIL")
+			for _, check := range report.Checks {
+				printRow(w, check.Status, check.Name, check.Detail)
+			}
+		})
+
+		if err != nil || report.failed() {
+			os.Exit(1)
+		}
+	}
+}
+
+// runDoctor runs the checks in order. Checks that depend on a failed one are skipped,
+// since their failure would only repeat the original problem.
+func runDoctor(cfg Config, report *doctorReport) {
+	keys := checkKeys(cfg, report)
+
+	peggyQuery, params := checkInjective(cfg, report)
+
+	ethClient := checkEthereum(cfg, report)
+
+	if ethClient != nil && params != nil {
+		checkPeggyContract(ethClient, params, report)
+	}
+
+	if keys != nil && peggyQuery != nil {
+		checkOrchestratorRegistration(peggyQuery, keys, report)
+	}
+
+	if keys != nil && ethClient != nil {
+		checkRelayerBalances(cfg, ethClient, keys.relayerAddrs, report)
+		checkNonces(cfg, ethClient, keys.relayerAddrs, report)
+	}
+
+	if params != nil {
+		var caller bind.ContractCaller
+		if ethClient != nil {
+			caller = ethClient
+		}
+
+		checkPriceFeed(cfg, caller, ethcmn.HexToAddress(params.CosmosCoinErc20Contract), report)
+	}
+}
+
+func checkKeys(cfg Config, report *doctorReport) *doctorKeys {
+	if *cfg.cosmosUseLedger || *cfg.ethUseLedger {
+		report.add("keys", checkFail, "cannot use Ledger for peggo, since signatures must be realtime")
+		return nil
+	}
+
+	valAddress, _, err := initCosmosKeyring(
+		cfg.cosmosKeyringDir,
+		cfg.cosmosKeyringAppName,
+		cfg.cosmosKeyringBackend,
+		cfg.cosmosKeyFrom,
+		cfg.cosmosKeyPassphrase,
+		cfg.cosmosPrivKey,
+		cfg.cosmosUseLedger,
+	)
+	if err != nil {
+		report.add("cosmos_key", checkFail, "failed to load Injective key: %v", err)
+		return nil
+	}
+
+	report.add("cosmos_key", checkPass, "using %s", valAddress.String())
+
+	ethAddr, _, _, err := initEthereumAccountsManager(
+		uint64(*cfg.ethChainID),
+		cfg.ethKeystoreDir,
+		cfg.ethKeyFrom,
+		cfg.ethPassphrase,
+		cfg.ethPrivKey,
+		cfg.ethUseLedger,
+		cfg.ethRemoteSignerURL,
+		cfg.ethRemoteSignerAPI,
+		cfg.ethRemoteSignerCACert,
+		cfg.ethRemoteSignerClientCert,
+		cfg.ethRemoteSignerClientKey,
+	)
+	if err != nil {
+		report.add("eth_key", checkFail, "failed to load Ethereum key: %v", err)
+		return nil
+	}
+
+	report.add("eth_key", checkPass, "using %s", ethAddr.Hex())
+
+	relayerAddrs := []ethcmn.Address{ethAddr}
+	if hasRelayerEthAccount(cfg) {
+		accounts, err := initRelayerEthAccounts(cfg)
+		if err != nil {
+			report.add("relayer_eth_key", checkFail, "failed to load relayer Ethereum accounts: %v", err)
+			return nil
+		}
+
+		relayerAddrs = relayerAddrs[:0]
+		for _, acc := range accounts {
+			relayerAddrs = append(relayerAddrs, acc.Address)
+		}
+
+		report.add("relayer_eth_key", checkPass, "using %d relayer account(s)", len(relayerAddrs))
+	}
+
+	return &doctorKeys{
+		valAddress:   valAddress,
+		ethAddr:      ethAddr,
+		relayerAddrs: relayerAddrs,
+	}
+}
+
+func checkInjective(cfg Config, report *doctorReport) (cosmos.PeggyQueryClient, *types.Params) {
+	ctx, cancelFn := context.WithTimeout(context.Background(), doctorCheckTimeout)
+	defer cancelFn()
+
+	tmRPC, err := rpchttp.New(*cfg.tendermintRPC, "/websocket")
+	if err != nil {
+		report.add("tendermint_rpc", checkFail, "invalid endpoint %s: %v", *cfg.tendermintRPC, err)
+	} else if status, err := tmRPC.Status(ctx); err != nil {
+		report.add("tendermint_rpc", checkFail, "%s is unreachable: %v", *cfg.tendermintRPC, err)
+	} else if status.NodeInfo.Network != *cfg.cosmosChainID {
+		report.add("cosmos_chain_id", checkFail, "node is on chain %s, but --cosmos-chain-id is %s", status.NodeInfo.Network, *cfg.cosmosChainID)
+	} else {
+		report.add("cosmos_chain_id", checkPass, "%s at height %d", status.NodeInfo.Network, status.SyncInfo.LatestBlockHeight)
+
+		if status.SyncInfo.CatchingUp {
+			report.add("cosmos_sync", checkWarn, "node is still catching up")
+		}
+	}
+
+	peggyQuery, err := dialPeggyQueryClient(ctx, *cfg.cosmosGRPC)
+	if err != nil {
+		report.add("cosmos_grpc", checkFail, "%s is unreachable: %v", *cfg.cosmosGRPC, err)
+		return nil, nil
+	}
+
+	params, err := peggyQuery.PeggyParams(ctx)
+	if err != nil {
+		report.add("cosmos_grpc", checkFail, "failed to query Peggy params: %v", err)
+		return nil, nil
+	}
+
+	report.add("cosmos_grpc", checkPass, "connected to %s", *cfg.cosmosGRPC)
+
+	if !ethcmn.IsHexAddress(params.BridgeEthereumAddress) {
+		report.add("peggy_params", checkFail, "invalid bridge contract address %q", params.BridgeEthereumAddress)
+		return peggyQuery, nil
+	}
+
+	report.add("peggy_params", checkPass, "contract %s, peggyID %s", params.BridgeEthereumAddress, params.PeggyId)
+
+	return peggyQuery, params
+}
+
+func checkEthereum(cfg Config, report *doctorReport) *ethclient.Client {
+	ctx, cancelFn := context.WithTimeout(context.Background(), doctorCheckTimeout)
+	defer cancelFn()
+
+	ethClient, err := ethclient.DialContext(ctx, *cfg.ethNodeRPC)
+	if err != nil {
+		report.add("eth_rpc", checkFail, "invalid endpoint %s: %v", *cfg.ethNodeRPC, err)
+		return nil
+	}
+
+	chainID, err := ethClient.ChainID(ctx)
+	if err != nil {
+		report.add("eth_rpc", checkFail, "%s is unreachable: %v", *cfg.ethNodeRPC, err)
+		return nil
+	}
+
+	report.add("eth_rpc", checkPass, "connected to %s", *cfg.ethNodeRPC)
+
+	if chainID.Cmp(big.NewInt(int64(*cfg.ethChainID))) != 0 {
+		report.add("eth_chain_id", checkFail, "node is on chain %s, but --eth-chain-id is %d", chainID, *cfg.ethChainID)
+	} else {
+		report.add("eth_chain_id", checkPass, "%s", chainID)
+	}
+
+	return ethClient
+}
+
+func checkPeggyContract(ethClient *ethclient.Client, params *types.Params, report *doctorReport) {
+	ctx, cancelFn := context.WithTimeout(context.Background(), doctorCheckTimeout)
+	defer cancelFn()
+
+	peggyAddr := ethcmn.HexToAddress(params.BridgeEthereumAddress)
+
+	code, err := ethClient.CodeAt(ctx, peggyAddr, nil)
+	if err != nil {
+		report.add("peggy_contract", checkFail, "failed to get contract code: %v", err)
+		return
+	} else if len(code) == 0 {
+		report.add("peggy_contract", checkFail, "no contract deployed at %s", peggyAddr.Hex())
+		return
+	}
+
+	peggyCaller, err := wrappers.NewPeggyCaller(peggyAddr, ethClient)
+	if err != nil {
+		report.add("peggy_contract", checkFail, "failed to bind contract: %v", err)
+		return
+	}
+
+	peggyID, err := peggyCaller.StatePeggyId(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		report.add("peggy_contract", checkFail, "failed to get peggyID, is %s a Peggy contract? %v", peggyAddr.Hex(), err)
+		return
+	}
+
+	// peggyID is stored in the contract as a string right-padded to bytes32
+	contractPeggyID := string(bytes.TrimRight(peggyID[:], "\x00"))
+	if contractPeggyID != params.PeggyId {
+		report.add("peggy_contract", checkFail, "contract peggyID %q doesn't match %q from params", contractPeggyID, params.PeggyId)
+		return
+	}
+
+	report.add("peggy_contract", checkPass, "%s has peggyID %s", peggyAddr.Hex(), contractPeggyID)
+}
+
+func checkOrchestratorRegistration(peggyQuery cosmos.PeggyQueryClient, keys *doctorKeys, report *doctorReport) {
+	ctx, cancelFn := context.WithTimeout(context.Background(), doctorCheckTimeout)
+	defer cancelFn()
+
+	ethAddr, valAddress := keys.ethAddr, keys.valAddress
+
+	isValidator, err := isValidatorAddress(peggyQuery, ethAddr)
+	if err != nil {
+		report.add("orchestrator_registration", checkFail, "failed to query current valset: %v", err)
+		return
+	} else if !isValidator {
+		report.add("orchestrator_registration", checkWarn, "%s is not in the current valset, peggo will run in relayer mode", ethAddr.Hex())
+		return
+	}
+
+	delegateKeys, err := peggyQuery.DelegateKeysByEthAddress(ctx, ethAddr)
+	if err != nil {
+		report.add("orchestrator_registration", checkFail, "%s is not registered, run `peggo tx register-eth-key`: %v", ethAddr.Hex(), err)
+		return
+	}
+
+	orchestratorAddr, err := cosmtypes.AccAddressFromBech32(delegateKeys.OrchestratorAddress)
+	if err != nil || !orchestratorAddr.Equals(valAddress) {
+		report.add("orchestrator_registration", checkFail, "%s is registered for orchestrator %s, not %s", ethAddr.Hex(), delegateKeys.OrchestratorAddress, valAddress.String())
+		return
+	}
+
+	report.add("orchestrator_registration", checkPass, "%s is the orchestrator key of validator %s", ethAddr.Hex(), delegateKeys.ValidatorAddress)
+}
+
+func checkRelayerBalances(cfg Config, ethClient *ethclient.Client, relayerAddrs []ethcmn.Address, report *doctorReport) {
+	ctx, cancelFn := context.WithTimeout(context.Background(), doctorCheckTimeout)
+	defer cancelFn()
+
+	minBalance, err := parseEthAmount(*cfg.relayerEthMinBalance)
+	if err != nil {
+		report.add("relayer_balance", checkFail, "%v", err)
+		return
+	}
+
+	for _, addr := range relayerAddrs {
+		balance, err := ethClient.BalanceAt(ctx, addr, nil)
+		if err != nil {
+			report.add("relayer_balance", checkFail, "failed to get balance of %s: %v", addr.Hex(), err)
+			continue
+		}
+
+		ethBalance := decimal.NewFromBigInt(balance, -18).String()
+
+		switch {
+		case balance.Sign() == 0:
+			report.add("relayer_balance", checkFail, "%s has no ETH to pay for relaying", addr.Hex())
+		case balance.Cmp(minBalance) < 0:
+			report.add("relayer_balance", checkFail, "%s has %s ETH, below the min balance of %s ETH", addr.Hex(), ethBalance, *cfg.relayerEthMinBalance)
+		default:
+			report.add("relayer_balance", checkPass, "%s has %s ETH", addr.Hex(), ethBalance)
+		}
+	}
+}
+
+func checkNonces(cfg Config, ethClient *ethclient.Client, relayerAddrs []ethcmn.Address, report *doctorReport) {
+	ctx, cancelFn := context.WithTimeout(context.Background(), doctorCheckTimeout)
+	defer cancelFn()
+
+	stuckTxTimeout := duration(*cfg.ethStuckTxTimeout, 0)
+
+	// the journal is only read, doctor must not create it. An unreadable journal is not fatal,
+	// the orchestrator falls back to the pending nonce
+	var journal map[ethcmn.Address][]util.NonceRecord
+	if len(*cfg.ethNonceJournal) > 0 {
+		records, err := util.ReadNonceJournal(*cfg.ethNonceJournal)
+		if err != nil {
+			report.add("nonce_journal", checkWarn, "failed to read %s: %v", *cfg.ethNonceJournal, err)
+		}
+
+		journal = records
+	}
+
+	for _, addr := range relayerAddrs {
+		minedNonce, err := ethClient.NonceAt(ctx, addr, nil)
+		if err != nil {
+			report.add("nonces", checkFail, "failed to get nonce of %s: %v", addr.Hex(), err)
+			continue
+		}
+
+		pendingNonce, err := ethClient.PendingNonceAt(ctx, addr)
+		if err != nil {
+			report.add("nonces", checkFail, "failed to get pending nonce of %s: %v", addr.Hex(), err)
+			continue
+		}
+
+		var stuck int
+		if stuckTxTimeout > 0 {
+			for _, rec := range journal[addr] {
+				if rec.Nonce >= minedNonce && time.Since(rec.SentAt) > stuckTxTimeout {
+					stuck++
+				}
+			}
+		}
+
+		switch {
+		case stuck > 0:
+			report.add("nonces", checkWarn, "%s has %d tx(s) pending for longer than %s, they'll be replaced on start", addr.Hex(), stuck, stuckTxTimeout)
+		case pendingNonce > minedNonce:
+			report.add("nonces", checkWarn, "%s has %d pending tx(s) at nonce %d", addr.Hex(), pendingNonce-minedNonce, minedNonce)
+		default:
+			report.add("nonces", checkPass, "%s is at nonce %d with no pending txs", addr.Hex(), minedNonce)
+		}
+	}
+}
+
+func checkPriceFeed(cfg Config, ethCaller bind.ContractCaller, injTokenAddr ethcmn.Address, report *doctorReport) {
+	if ethCaller == nil && len(*cfg.chainlinkFeeds) > 0 {
+		report.add("price_feed", checkFail, "Chainlink feeds need a reachable Ethereum node")
+		return
+	}
+
+	priceFeed, err := initPriceFeed(cfg, ethCaller)
+	if err != nil {
+		report.add("price_feed", checkFail, "failed to initialize price feed: %v", err)
+		return
+	}
+
+	price, err := priceFeed.QueryUSDPrice(injTokenAddr)
+	if err != nil {
+		report.add("price_feed", checkWarn, "failed to get the INJ price, batch fee thresholds can't be checked: %v", err)
+		return
+	}
+
+	report.add("price_feed", checkPass, "INJ is %.4f USD", price)
+}
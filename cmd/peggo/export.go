@@ -0,0 +1,249 @@
+package main
+
+import (
+	"context"
+	"os"
+	"time"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	cli "github.com/jawher/mow.cli"
+	"github.com/pkg/errors"
+	"github.com/xlab/closer"
+	log "github.com/xlab/suplog"
+
+	"github.com/InjectiveLabs/peggo/orchestrator/ethereum"
+	"github.com/InjectiveLabs/peggo/orchestrator/export"
+)
+
+// exportCmd writes the deposits and withdrawals of the bridge to a file.
+//
+// $ peggo export
+func exportCmd(cmd *cli.Cmd) {
+	cmd.Spec = "--out [OPTIONS]"
+
+	cosmosGRPC := cmd.String(cli.StringOpt{
+		Name:   "cosmos-grpc",
+		Desc:   "Cosmos GRPC querying endpoint",
+		EnvVar: "PEGGO_COSMOS_GRPC",
+		Value:  "tcp://localhost:9900",
+	})
+
+	ethNodeRPC := initEthereumRPCOption(cmd)
+
+	peggyContract := cmd.String(cli.StringOpt{
+		Name:   "peggy-contract",
+		Desc:   "Address of the Peggy contract, read from the Peggy module params if not set",
+		EnvVar: "PEGGO_PEGGY_CONTRACT",
+	})
+
+	out := cmd.String(cli.StringOpt{
+		Name: "out",
+		Desc: "File the records are appended to",
+	})
+
+	format := cmd.String(cli.StringOpt{
+		Name:  "format",
+		Desc:  "Format of the records (csv|jsonl)",
+		Value: export.FormatCSV,
+	})
+
+	stateFile := cmd.String(cli.StringOpt{
+		Name: "state-file",
+		Desc: "File keeping the last exported block, defaults to <out>.state",
+	})
+
+	fromBlock := cmd.Int(cli.IntOpt{
+		Name: "from-block",
+		Desc: "First Ethereum block to export, defaults to the block after the last exported one",
+	})
+
+	toBlock := cmd.Int(cli.IntOpt{
+		Name: "to-block",
+		Desc: "Last Ethereum block to export, defaults to the latest confirmed block",
+	})
+
+	fromDate := cmd.String(cli.StringOpt{
+		Name: "from-date",
+		Desc: "Export blocks mined at or after the date (2006-01-02 or RFC3339)",
+	})
+
+	toDate := cmd.String(cli.StringOpt{
+		Name: "to-date",
+		Desc: "Export blocks mined before the date (2006-01-02 or RFC3339)",
+	})
+
+	confirmations := cmd.Int(cli.IntOpt{
+		Name:  "confirmations",
+		Desc:  "Number of blocks behind the latest one to stop at, so reorged events are not exported",
+		Value: 96,
+	})
+
+	cmd.Action = func() {
+		// ensure a clean exit
+		defer closer.Close()
+
+		if *format != export.FormatCSV && *format != export.FormatJSONL {
+			log.WithField("format", *format).Fatalln("unknown export format")
+		}
+
+		if *stateFile == "" {
+			*stateFile = *out + ".state"
+		}
+
+		ctx, cancelFn := context.WithCancel(context.Background())
+		closer.Bind(cancelFn)
+
+		peggyQuery, err := dialPeggyQueryClient(ctx, *cosmosGRPC)
+		if err != nil {
+			log.WithError(err).Fatalln("failed to connect to Injective")
+		}
+
+		peggyAddr, err := resolvePeggyContract(ctx, peggyQuery, *peggyContract)
+		if err != nil {
+			log.WithError(err).Fatalln("failed to resolve Peggy contract")
+		}
+
+		// the network is only used for calls, so it has no signer
+		ethNetwork, err := ethereum.NewNetwork(*ethNodeRPC, peggyAddr, ethcmn.Address{}, nil, 1, "500gwei", "20m", "")
+		if err != nil {
+			log.WithError(err).Fatalln("failed to connect to Ethereum")
+		}
+
+		latestHeader, err := ethNetwork.HeaderByNumber(ctx, nil)
+		if err != nil {
+			log.WithError(err).Fatalln("failed to get latest Ethereum header")
+		}
+
+		latestBlock := latestHeader.Number.Uint64()
+		if latestBlock < uint64(*confirmations) {
+			log.Fatalln("not enough confirmed blocks to export")
+		}
+
+		confirmedBlock := latestBlock - uint64(*confirmations)
+
+		state, err := export.LoadState(*stateFile)
+		if err != nil {
+			log.WithError(err).Fatalln("failed to load export state")
+		}
+
+		var from uint64
+		switch {
+		case *fromBlock > 0:
+			from = uint64(*fromBlock)
+		case *fromDate != "":
+			from = blockAtDate(ctx, ethNetwork, *fromDate, confirmedBlock)
+		case state != nil:
+			from = state.LastBlock + 1
+		default:
+			peggyParams, err := peggyQuery.PeggyParams(ctx)
+			if err != nil {
+				log.WithError(err).Fatalln("failed to query peggy params")
+			}
+
+			from = peggyParams.BridgeContractStartHeight
+		}
+
+		to := confirmedBlock
+		switch {
+		case *toBlock > 0 && uint64(*toBlock) < to:
+			to = uint64(*toBlock)
+		case *toDate != "":
+			block := blockAtDate(ctx, ethNetwork, *toDate, confirmedBlock)
+			if block == 0 {
+				log.WithField("to_date", *toDate).Infoln("nothing to export")
+				return
+			}
+
+			if block <= to {
+				to = block - 1
+			}
+		}
+
+		if from > to {
+			log.WithFields(log.Fields{"from": from, "to": to}).Infoln("nothing to export")
+			return
+		}
+
+		// records are only appended, so exporting blocks again would duplicate them
+		if state != nil && from <= state.LastBlock {
+			log.WithFields(log.Fields{
+				"from":       from,
+				"last_block": state.LastBlock,
+				"out":        *out,
+			}).Fatalln("blocks up to last_block are already exported to out, use a different --out to export them again")
+		}
+
+		outFile, err := os.OpenFile(*out, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+		if err != nil {
+			log.WithError(err).Fatalln("failed to open export file")
+		}
+		defer outFile.Close()
+
+		outInfo, err := outFile.Stat()
+		if err != nil {
+			log.WithError(err).Fatalln("failed to stat export file")
+		}
+
+		w, err := export.NewWriter(*format, outFile, outInfo.Size() == 0)
+		if err != nil {
+			log.WithError(err).Fatalln("failed to init export writer")
+		}
+
+		log.WithFields(log.Fields{
+			"from":   from,
+			"to":     to,
+			"out":    *out,
+			"format": *format,
+		}).Infoln("exporting bridge history")
+
+		var (
+			exported  bool
+			lastBlock uint64
+		)
+
+		exporter := export.NewExporter(ethNetwork, peggyQuery)
+		err = exporter.Export(ctx, from, to, w, func(chunkLastBlock uint64) error {
+			exported, lastBlock = true, chunkLastBlock
+
+			return export.SaveState(*stateFile, &export.State{LastBlock: chunkLastBlock})
+		})
+		if err != nil {
+			log.WithError(err).Fatalln("export failed")
+		}
+
+		if !exported {
+			log.WithField("from", from).Infoln("nothing exported, waiting for pending deposits to be observed")
+			return
+		}
+
+		log.WithField("last_block", lastBlock).Infoln("export done")
+	}
+}
+
+// blockAtDate returns the first block mined at or after the date, up to maxBlock+1.
+func blockAtDate(ctx context.Context, ethNetwork *ethereum.Network, date string, maxBlock uint64) uint64 {
+	t, err := parseDate(date)
+	if err != nil {
+		log.WithError(err).Fatalln("invalid date")
+	}
+
+	block, err := export.BlockAtTime(ctx, ethNetwork, t, 0, maxBlock)
+	if err != nil {
+		log.WithError(err).Fatalln("failed to find block at date")
+	}
+
+	return block
+}
+
+func parseDate(s string) (time.Time, error) {
+	if t, err := time.Parse("2006-01-02", s); err == nil {
+		return t, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, errors.Errorf("date %s is neither 2006-01-02 nor RFC3339", s)
+	}
+
+	return t, nil
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"bytes"
+	"context"
 	"crypto/rand"
 	"fmt"
 	"io"
@@ -11,6 +12,7 @@ import (
 	"path/filepath"
 	"strings"
 	"syscall"
+	"time"
 
 	cosmcrypto "github.com/cosmos/cosmos-sdk/crypto"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
@@ -184,6 +186,11 @@ func initEthereumAccountsManager(
 	ethPassphrase *string,
 	ethPrivKey *string,
 	ethUseLedger *bool,
+	ethRemoteSignerURL *string,
+	ethRemoteSignerAPI *string,
+	ethRemoteSignerCACert *string,
+	ethRemoteSignerClientCert *string,
+	ethRemoteSignerClientKey *string,
 ) (
 	ethKeyFromAddress ethcmn.Address,
 	signerFn bind.SignerFn,
@@ -269,6 +276,39 @@ func initEthereumAccountsManager(
 
 		return ethKeyFromAddress, signerFn, personalSignFn, nil
 
+	case len(*ethRemoteSignerURL) > 0:
+		if len(*ethKeyFrom) == 0 {
+			err := errors.New("cannot use remote signer without from address specified")
+			return emptyEthAddress, nil, nil, err
+		}
+
+		ethKeyFromAddress = ethcmn.HexToAddress(*ethKeyFrom)
+		if ethKeyFromAddress == (ethcmn.Address{}) {
+			err = errors.New("failed to parse Ethereum from address")
+			return emptyEthAddress, nil, nil, err
+		}
+
+		remoteSigner, err := keystore.NewRemoteSigner(ethChainID, keystore.RemoteSignerConfig{
+			URL:        *ethRemoteSignerURL,
+			API:        keystore.RemoteSignerAPI(*ethRemoteSignerAPI),
+			CACert:     *ethRemoteSignerCACert,
+			ClientCert: *ethRemoteSignerClientCert,
+			ClientKey:  *ethRemoteSignerClientKey,
+		})
+		if err != nil {
+			err = errors.Wrap(err, "failed to init remote signer")
+			return emptyEthAddress, nil, nil, err
+		}
+
+		ctx, cancelFn := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancelFn()
+
+		if err := remoteSigner.HasAccount(ctx, ethKeyFromAddress); err != nil {
+			return emptyEthAddress, nil, nil, err
+		}
+
+		return ethKeyFromAddress, remoteSigner.SignerFn(), remoteSigner.PersonalSignFn(), nil
+
 	case len(*ethPrivKey) > 0:
 		ethPk, err := crypto.HexToECDSA(*ethPrivKey)
 		if err != nil {
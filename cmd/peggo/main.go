@@ -31,8 +31,12 @@ func main() {
 	}
 
 	app.Command("orchestrator", "Starts the orchestrator main loop.", orchestratorCmd)
+	app.Command("doctor", "Checks the orchestrator configuration against Injective and Ethereum.", doctorCmd)
 	app.Command("q query", "Query commands that can get state info from Peggy.", queryCmdSubset)
 	app.Command("tx", "Transactions for Peggy governance and maintenance.", txCmdSubset)
+	app.Command("contract", "Admin actions of the Peggy contract owner.", contractCmdSubset)
+	app.Command("verify", "Consistency checks between Injective and the Peggy contract.", verifyCmdSubset)
+	app.Command("export", "Exports the bridge deposits and withdrawals to CSV or JSON lines.", exportCmd)
 	app.Command("version", "Print the version information and exit.", versionCmd)
 
 	_ = app.Run(os.Args)
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"net/http"
 	"os"
 	"time"
 
@@ -8,41 +9,61 @@ import (
 	cli "github.com/jawher/mow.cli"
 	"github.com/xlab/closer"
 	log "github.com/xlab/suplog"
+
+	"github.com/InjectiveLabs/peggo/orchestrator/health"
+	peggometrics "github.com/InjectiveLabs/peggo/orchestrator/metrics"
 )
 
-func initMetrics(c *cli.Cmd) {
-	var (
-		statsdAgent    *string
-		statsdPrefix   *string
-		statsdAddr     *string
-		statsdStuckDur *string
-		statsdMocking  *string
-		statsdDisabled *string
-	)
+type metricsOptions struct {
+	statsdAgent    *string
+	statsdPrefix   *string
+	statsdAddr     *string
+	statsdStuckDur *string
+	statsdMocking  *string
+	statsdDisabled *string
+
+	metricsListen *string
+
+	// health is served with the metrics, its endpoints are set once connected
+	health *health.Checker
+}
+
+// initMetricsOptions registers the metrics options, they must be known
+// before the command line is parsed.
+func initMetricsOptions(c *cli.Cmd) *metricsOptions {
+	opts := &metricsOptions{
+		health: health.NewChecker(),
+	}
 
 	initStatsdOptions(
 		c,
-		&statsdAgent,
-		&statsdPrefix,
-		&statsdAddr,
-		&statsdStuckDur,
-		&statsdMocking,
-		&statsdDisabled,
+		&opts.statsdAgent,
+		&opts.statsdPrefix,
+		&opts.statsdAddr,
+		&opts.statsdStuckDur,
+		&opts.statsdMocking,
+		&opts.statsdDisabled,
 	)
 
-	if toBool(*statsdDisabled) {
+	initPrometheusOptions(c, &opts.metricsListen)
+
+	return opts
+}
+
+func initMetrics(opts *metricsOptions) {
+	if toBool(*opts.statsdDisabled) {
 		// initializes statsd client with a mock one with no-op enabled
 		metrics.Disable()
 	} else {
 		go func() {
 			for {
 				hostname, _ := os.Hostname()
-				err := metrics.Init(*statsdAddr, checkStatsdPrefix(*statsdPrefix), &metrics.StatterConfig{
-					Agent:                *statsdAgent,
+				err := metrics.Init(*opts.statsdAddr, checkStatsdPrefix(*opts.statsdPrefix), &metrics.StatterConfig{
+					Agent:                *opts.statsdAgent,
 					EnvName:              *envName,
 					HostName:             hostname,
-					StuckFunctionTimeout: duration(*statsdStuckDur, 30*time.Minute),
-					MockingEnabled:       toBool(*statsdMocking) || *envName == "local",
+					StuckFunctionTimeout: duration(*opts.statsdStuckDur, 30*time.Minute),
+					MockingEnabled:       toBool(*opts.statsdMocking) || *envName == "local",
 				})
 				if err != nil {
 					log.WithError(err).Warningln("metrics init failed, will retry in 1 min")
@@ -56,4 +77,36 @@ func initMetrics(c *cli.Cmd) {
 			})
 		}()
 	}
+
+	if *opts.metricsListen != "" {
+		startMetricsServer(*opts.metricsListen, opts.health)
+	}
+}
+
+// startMetricsServer serves the Prometheus metrics, alongside or instead of statsd,
+// and the health endpoints.
+func startMetricsServer(listenAddr string, checker *health.Checker) {
+	peggometrics.EnablePrometheus()
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", peggometrics.Handler())
+	checker.Register(mux)
+
+	server := &http.Server{
+		Addr:              listenAddr,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		log.WithField("addr", listenAddr).Infoln("serving Prometheus metrics and health endpoints")
+
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.WithError(err).Fatalln("metrics server failed")
+		}
+	}()
+
+	closer.Bind(func() {
+		_ = server.Close()
+	})
 }
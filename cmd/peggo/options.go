@@ -1,6 +1,11 @@
 package main
 
-import cli "github.com/jawher/mow.cli"
+import (
+	"os"
+	"path/filepath"
+
+	cli "github.com/jawher/mow.cli"
+)
 
 // initGlobalOptions defines some global CLI options, that are useful for most parts of the app.
 // Before adding option to there, consider moving it into the actual Cmd.
@@ -144,6 +149,11 @@ func initEthereumKeyOptions(
 	ethPassphrase **string,
 	ethPrivKey **string,
 	ethUseLedger **bool,
+	ethRemoteSignerURL **string,
+	ethRemoteSignerAPI **string,
+	ethRemoteSignerCACert **string,
+	ethRemoteSignerClientCert **string,
+	ethRemoteSignerClientKey **string,
 ) {
 	*ethKeystoreDir = cmd.String(cli.StringOpt{
 		Name:   "eth-keystore-dir",
@@ -175,6 +185,69 @@ func initEthereumKeyOptions(
 		EnvVar: "PEGGO_ETH_USE_LEDGER",
 		Value:  false,
 	})
+
+	*ethRemoteSignerURL = cmd.String(cli.StringOpt{
+		Name:   "eth-remote-signer",
+		Desc:   "Specify URL of a remote signer (Web3Signer or Clef) holding the key for the from address.",
+		EnvVar: "PEGGO_ETH_REMOTE_SIGNER",
+	})
+
+	*ethRemoteSignerAPI = cmd.String(cli.StringOpt{
+		Name:   "eth-remote-signer-api",
+		Desc:   "Remote signer JSON-RPC API flavour (web3signer|clef).",
+		EnvVar: "PEGGO_ETH_REMOTE_SIGNER_API",
+		Value:  "web3signer",
+	})
+
+	*ethRemoteSignerCACert = cmd.String(cli.StringOpt{
+		Name:   "eth-remote-signer-tls-ca",
+		Desc:   "Path to a PEM CA certificate used to verify the remote signer.",
+		EnvVar: "PEGGO_ETH_REMOTE_SIGNER_TLS_CA",
+	})
+
+	*ethRemoteSignerClientCert = cmd.String(cli.StringOpt{
+		Name:   "eth-remote-signer-tls-cert",
+		Desc:   "Path to a PEM client certificate for mutual TLS with the remote signer.",
+		EnvVar: "PEGGO_ETH_REMOTE_SIGNER_TLS_CERT",
+	})
+
+	*ethRemoteSignerClientKey = cmd.String(cli.StringOpt{
+		Name:   "eth-remote-signer-tls-key",
+		Desc:   "Path to a PEM client key for mutual TLS with the remote signer.",
+		EnvVar: "PEGGO_ETH_REMOTE_SIGNER_TLS_KEY",
+	})
+}
+
+// initEthereumOptions sets the Ethereum node options of commands that send txs to the Peggy contract.
+func initEthereumOptions(
+	cmd *cli.Cmd,
+	ethChainID **int,
+	ethNodeRPC **string,
+	ethGasPriceAdjustment **float64,
+	ethMaxGasPrice **string,
+) {
+	*ethChainID = cmd.Int(cli.IntOpt{
+		Name:   "eth-chain-id",
+		Desc:   "Specify Chain ID of the Ethereum network.",
+		EnvVar: "PEGGO_ETH_CHAIN_ID",
+		Value:  42,
+	})
+
+	*ethNodeRPC = initEthereumRPCOption(cmd)
+
+	*ethGasPriceAdjustment = cmd.Float64(cli.Float64Opt{
+		Name:   "eth_gas_price_adjustment",
+		Desc:   "gas price adjustment for Ethereum transactions",
+		EnvVar: "PEGGO_ETH_GAS_PRICE_ADJUSTMENT",
+		Value:  float64(1.3),
+	})
+
+	*ethMaxGasPrice = cmd.String(cli.StringOpt{
+		Name:   "eth-max-gas-price",
+		Desc:   "Specify Max gas price for Ethereum Transactions in GWei",
+		EnvVar: "PEGGO_ETH_MAX_GAS_PRICE",
+		Value:  "500gwei",
+	})
 }
 
 // initStatsdOptions sets options for StatsD metrics.
@@ -230,6 +303,75 @@ func initStatsdOptions(
 	})
 }
 
+// initPrometheusOptions sets options for the Prometheus metrics server.
+func initPrometheusOptions(
+	cmd *cli.Cmd,
+	metricsListen **string,
+) {
+	*metricsListen = cmd.String(cli.StringOpt{
+		Name:   "metrics-listen",
+		Desc:   "Address of the HTTP server exposing Prometheus metrics on /metrics and health on /healthz and /readyz, e.g. 0.0.0.0:9090. Disabled if empty.",
+		EnvVar: "PEGGO_METRICS_LISTEN",
+	})
+}
+
+// initAlertOptions sets options for the alert sinks.
+func initAlertOptions(
+	cmd *cli.Cmd,
+	alertWebhookURL **string,
+	alertSlackURL **string,
+	alertPagerDutyKey **string,
+	alertCommand **string,
+	alertMinSeverity **string,
+	alertDedupWindow **string,
+	alertRateLimit **int,
+) {
+	*alertWebhookURL = cmd.String(cli.StringOpt{
+		Name:   "alert-webhook-url",
+		Desc:   "URL receiving alerts as JSON. Disabled if empty.",
+		EnvVar: "PEGGO_ALERT_WEBHOOK_URL",
+	})
+
+	*alertSlackURL = cmd.String(cli.StringOpt{
+		Name:   "alert-slack-url",
+		Desc:   "Slack incoming webhook URL receiving alerts. Disabled if empty.",
+		EnvVar: "PEGGO_ALERT_SLACK_URL",
+	})
+
+	*alertPagerDutyKey = cmd.String(cli.StringOpt{
+		Name:   "alert-pagerduty-key",
+		Desc:   "PagerDuty Events API v2 routing key, alerts trigger incidents. Disabled if empty.",
+		EnvVar: "PEGGO_ALERT_PAGERDUTY_KEY",
+	})
+
+	*alertCommand = cmd.String(cli.StringOpt{
+		Name:   "alert-command",
+		Desc:   "Shell command run for every alert, with the alert JSON on stdin. Disabled if empty.",
+		EnvVar: "PEGGO_ALERT_COMMAND",
+	})
+
+	*alertMinSeverity = cmd.String(cli.StringOpt{
+		Name:   "alert-min-severity",
+		Desc:   "Minimum severity of the alerts sent (info|warning|critical).",
+		EnvVar: "PEGGO_ALERT_MIN_SEVERITY",
+		Value:  "warning",
+	})
+
+	*alertDedupWindow = cmd.String(cli.StringOpt{
+		Name:   "alert-dedup-window",
+		Desc:   "Alerts for the same condition are not repeated within this duration.",
+		EnvVar: "PEGGO_ALERT_DEDUP_WINDOW",
+		Value:  "1h",
+	})
+
+	*alertRateLimit = cmd.Int(cli.IntOpt{
+		Name:   "alert-rate-limit",
+		Desc:   "Max number of alerts sent per minute, critical alerts are never rate limited. Unlimited if 0.",
+		EnvVar: "PEGGO_ALERT_RATE_LIMIT",
+		Value:  10,
+	})
+}
+
 type Config struct {
 	// Cosmos params
 	cosmosChainID   *string
@@ -253,6 +395,19 @@ type Config struct {
 	ethNodeAlchemyWS      *string
 	ethGasPriceAdjustment *float64
 	ethMaxGasPrice        *string
+	ethNonceJournal       *string
+	ethStuckTxTimeout     *string
+
+	// Ethereum gas price strategies
+	ethGasPriceStrategyValset  *string
+	ethGasPriceStrategyBatch   *string
+	ethFixedGasPrice           *string
+	ethFeeHistoryBlocks        *int
+	ethFeeHistoryPercentile    *float64
+	ethGasStationURL           *string
+	ethGasStationField         *string
+	ethGasUrgencyBlocks        *int
+	ethGasUrgencyMaxMultiplier *float64
 
 	// Ethereum Key Management
 	ethKeystoreDir *string
@@ -261,6 +416,25 @@ type Config struct {
 	ethPrivKey     *string
 	ethUseLedger   *bool
 
+	ethRemoteSignerURL        *string
+	ethRemoteSignerAPI        *string
+	ethRemoteSignerCACert     *string
+	ethRemoteSignerClientCert *string
+	ethRemoteSignerClientKey  *string
+
+	// Relayer Ethereum account, pays gas for relayed txs
+	relayerEthKeystoreDir     *string
+	relayerEthKeyFrom         *string
+	relayerEthPassphrase      *string
+	relayerEthPrivKey         *string
+	relayerEthRemoteSignerURL *string
+	relayerEthMinBalance      *string
+
+	// Relayer account balance watch
+	relayerBalanceWatch      *bool
+	relayerBalanceFloor      *string
+	relayerBalanceWarnRelays *string
+
 	// Relayer config
 	relayValsets          *bool
 	relayValsetOffsetDur  *string
@@ -268,10 +442,31 @@ type Config struct {
 	relayBatchOffsetDur   *string
 	pendingTxWaitDuration *string
 
+	// ERC20 token mapping overrides
+	erc20Mapping     *string
+	erc20MappingFile *string
+
 	// Batch requester config
 	minBatchFeeUSD *float64
+	batchPolicy    *string
+
+	dynamicBatchFee           *bool
+	dynamicBatchFeeMultiplier *float64
+	batchBaseGas              *int
+	batchGasPerTx             *int
+	batchMaxTxs               *int
+	wethAddress               *string
 
 	coingeckoApi *string
+
+	// Price feed config
+	priceFeedCoingecko    *bool
+	chainlinkFeeds        *string
+	priceFeedSources      *string
+	priceFeedCacheTTL     *string
+	priceFeedMaxStaleness *string
+	priceFeedMaxDeviation *float64
+	priceFeedPrimary      *string
 }
 
 func initConfig(cmd *cli.Cmd) Config {
@@ -363,12 +558,7 @@ func initConfig(cmd *cli.Cmd) Config {
 		Value:  42,
 	})
 
-	cfg.ethNodeRPC = cmd.String(cli.StringOpt{
-		Name:   "eth-node-http",
-		Desc:   "Specify HTTP endpoint for an Ethereum node.",
-		EnvVar: "PEGGO_ETH_RPC",
-		Value:  "http://localhost:1317",
-	})
+	cfg.ethNodeRPC = initEthereumRPCOption(cmd)
 
 	cfg.ethNodeAlchemyWS = cmd.String(cli.StringOpt{
 		Name:   "eth-node-alchemy-ws",
@@ -391,6 +581,83 @@ func initConfig(cmd *cli.Cmd) Config {
 		Value:  "500gwei",
 	})
 
+	cfg.ethNonceJournal = cmd.String(cli.StringOpt{
+		Name:   "eth-nonce-journal",
+		Desc:   "Path to a file that records nonces used by relayer accounts, to recover pending txs and nonce gaps after restarts. Set to empty to keep it in memory only.",
+		EnvVar: "PEGGO_ETH_NONCE_JOURNAL",
+		Value:  defaultNonceJournalPath(),
+	})
+
+	cfg.ethStuckTxTimeout = cmd.String(cli.StringOpt{
+		Name:   "eth-stuck-tx-timeout",
+		Desc:   "Re-send relayer txs that stay unmined for longer than this duration with a bumped gas price. Set to 0 to disable.",
+		EnvVar: "PEGGO_ETH_STUCK_TX_TIMEOUT",
+		Value:  "30m",
+	})
+
+	cfg.ethGasPriceStrategyValset = cmd.String(cli.StringOpt{
+		Name:   "eth-gas-price-strategy-valset",
+		Desc:   "Gas price strategy for valset updates: suggested, feehistory, fixed or gasstation",
+		EnvVar: "PEGGO_ETH_GAS_PRICE_STRATEGY_VALSET",
+		Value:  "suggested",
+	})
+
+	cfg.ethGasPriceStrategyBatch = cmd.String(cli.StringOpt{
+		Name:   "eth-gas-price-strategy-batch",
+		Desc:   "Gas price strategy for batches: suggested, feehistory, fixed or gasstation",
+		EnvVar: "PEGGO_ETH_GAS_PRICE_STRATEGY_BATCH",
+		Value:  "suggested",
+	})
+
+	cfg.ethFixedGasPrice = cmd.String(cli.StringOpt{
+		Name:   "eth-fixed-gas-price",
+		Desc:   "Gas price used by the fixed strategy, in wei or gwei (e.g. 50gwei)",
+		EnvVar: "PEGGO_ETH_FIXED_GAS_PRICE",
+		Value:  "",
+	})
+
+	cfg.ethFeeHistoryBlocks = cmd.Int(cli.IntOpt{
+		Name:   "eth-fee-history-blocks",
+		Desc:   "Number of recent blocks the feehistory strategy looks at",
+		EnvVar: "PEGGO_ETH_FEE_HISTORY_BLOCKS",
+		Value:  20,
+	})
+
+	cfg.ethFeeHistoryPercentile = cmd.Float64(cli.Float64Opt{
+		Name:   "eth-fee-history-percentile",
+		Desc:   "Percentile of priority fees paid in recent blocks used by the feehistory strategy",
+		EnvVar: "PEGGO_ETH_FEE_HISTORY_PERCENTILE",
+		Value:  60,
+	})
+
+	cfg.ethGasStationURL = cmd.String(cli.StringOpt{
+		Name:   "eth-gas-station-url",
+		Desc:   "URL of a JSON gas station API used by the gasstation strategy",
+		EnvVar: "PEGGO_ETH_GAS_STATION_URL",
+		Value:  "",
+	})
+
+	cfg.ethGasStationField = cmd.String(cli.StringOpt{
+		Name:   "eth-gas-station-field",
+		Desc:   "Dot-separated path to the gas price (in gwei) in the gas station response, e.g. result.FastGasPrice",
+		EnvVar: "PEGGO_ETH_GAS_STATION_FIELD",
+		Value:  "",
+	})
+
+	cfg.ethGasUrgencyBlocks = cmd.Int(cli.IntOpt{
+		Name:   "eth-gas-urgency-blocks",
+		Desc:   "Start raising the gas price of a batch this many Ethereum blocks before its timeout. Set to 0 to disable.",
+		EnvVar: "PEGGO_ETH_GAS_URGENCY_BLOCKS",
+		Value:  0,
+	})
+
+	cfg.ethGasUrgencyMaxMultiplier = cmd.Float64(cli.Float64Opt{
+		Name:   "eth-gas-urgency-max-multiplier",
+		Desc:   "Gas price multiplier a batch reaches at its timeout, the price is still capped by eth-max-gas-price",
+		EnvVar: "PEGGO_ETH_GAS_URGENCY_MAX_MULTIPLIER",
+		Value:  2,
+	})
+
 	cfg.ethKeystoreDir = cmd.String(cli.StringOpt{
 		Name:   "eth-keystore-dir",
 		Desc:   "Specify Ethereum keystore dir (Geth-format) prefix.",
@@ -422,6 +689,97 @@ func initConfig(cmd *cli.Cmd) Config {
 		Value:  false,
 	})
 
+	cfg.ethRemoteSignerURL = cmd.String(cli.StringOpt{
+		Name:   "eth-remote-signer",
+		Desc:   "Specify URL of a remote signer (Web3Signer or Clef) holding the key for the from address.",
+		EnvVar: "PEGGO_ETH_REMOTE_SIGNER",
+	})
+
+	cfg.ethRemoteSignerAPI = cmd.String(cli.StringOpt{
+		Name:   "eth-remote-signer-api",
+		Desc:   "Remote signer JSON-RPC API flavour (web3signer|clef).",
+		EnvVar: "PEGGO_ETH_REMOTE_SIGNER_API",
+		Value:  "web3signer",
+	})
+
+	cfg.ethRemoteSignerCACert = cmd.String(cli.StringOpt{
+		Name:   "eth-remote-signer-tls-ca",
+		Desc:   "Path to a PEM CA certificate used to verify the remote signer.",
+		EnvVar: "PEGGO_ETH_REMOTE_SIGNER_TLS_CA",
+	})
+
+	cfg.ethRemoteSignerClientCert = cmd.String(cli.StringOpt{
+		Name:   "eth-remote-signer-tls-cert",
+		Desc:   "Path to a PEM client certificate for mutual TLS with the remote signer.",
+		EnvVar: "PEGGO_ETH_REMOTE_SIGNER_TLS_CERT",
+	})
+
+	cfg.ethRemoteSignerClientKey = cmd.String(cli.StringOpt{
+		Name:   "eth-remote-signer-tls-key",
+		Desc:   "Path to a PEM client key for mutual TLS with the remote signer.",
+		EnvVar: "PEGGO_ETH_REMOTE_SIGNER_TLS_KEY",
+	})
+
+	/** Relayer Ethereum account **/
+
+	cfg.relayerEthKeystoreDir = cmd.String(cli.StringOpt{
+		Name:   "relayer-eth-keystore-dir",
+		Desc:   "Specify Ethereum keystore dir (Geth-format) prefix for the relayer account. If no relayer account is set, the orchestrator key is used.",
+		EnvVar: "PEGGO_RELAYER_ETH_KEYSTORE_DIR",
+	})
+
+	cfg.relayerEthKeyFrom = cmd.String(cli.StringOpt{
+		Name:   "relayer-eth-from",
+		Desc:   "Specify the relayer account address that pays gas for relayed transactions. A comma-separated list sets up a pool of sender accounts.",
+		EnvVar: "PEGGO_RELAYER_ETH_FROM",
+	})
+
+	cfg.relayerEthPassphrase = cmd.String(cli.StringOpt{
+		Name:   "relayer-eth-passphrase",
+		Desc:   "Passphrase to unlock the relayer private key from armor, if empty then stdin is used.",
+		EnvVar: "PEGGO_RELAYER_ETH_PASSPHRASE",
+	})
+
+	cfg.relayerEthPrivKey = cmd.String(cli.StringOpt{
+		Name:   "relayer-eth-pk",
+		Desc:   "Provide a raw Ethereum private key of the relayer account in hex, or a comma-separated list for a pool. USE FOR TESTING ONLY!",
+		EnvVar: "PEGGO_RELAYER_ETH_PK",
+	})
+
+	cfg.relayerEthRemoteSignerURL = cmd.String(cli.StringOpt{
+		Name:   "relayer-eth-remote-signer",
+		Desc:   "Specify URL of a remote signer holding the relayer key. Uses the same API and TLS settings as --eth-remote-signer.",
+		EnvVar: "PEGGO_RELAYER_ETH_REMOTE_SIGNER",
+	})
+
+	cfg.relayerEthMinBalance = cmd.String(cli.StringOpt{
+		Name:   "relayer-eth-min-balance",
+		Desc:   "Minimum balance in ETH a relayer account must hold to be used for sending transactions.",
+		EnvVar: "PEGGO_RELAYER_ETH_MIN_BALANCE",
+		Value:  "0",
+	})
+
+	cfg.relayerBalanceWatch = cmd.Bool(cli.BoolOpt{
+		Name:   "relayer-balance-watch",
+		Desc:   "Watch the relayer account balance, warn when it runs low and pause relaying when it can't pay for it, instead of exiting.",
+		EnvVar: "PEGGO_RELAYER_BALANCE_WATCH",
+		Value:  true,
+	})
+
+	cfg.relayerBalanceFloor = cmd.String(cli.StringOpt{
+		Name:   "relayer-balance-floor",
+		Desc:   "Balance in ETH of the relayer account below which relaying is paused until the account is topped up.",
+		EnvVar: "PEGGO_RELAYER_BALANCE_FLOOR",
+		Value:  "0",
+	})
+
+	cfg.relayerBalanceWarnRelays = cmd.String(cli.StringOpt{
+		Name:   "relayer-balance-warn-relays",
+		Desc:   "Comma-separated numbers of remaining relays the relayer account can pay for at which a low balance warning is raised.",
+		EnvVar: "PEGGO_RELAYER_BALANCE_WARN_RELAYS",
+		Value:  "50,10",
+	})
+
 	/** Relayer **/
 
 	cfg.relayValsets = cmd.Bool(cli.BoolOpt{
@@ -459,6 +817,22 @@ func initConfig(cmd *cli.Cmd) Config {
 		Value:  "20m",
 	})
 
+	/** ERC20 mapping **/
+
+	cfg.erc20Mapping = cmd.String(cli.StringOpt{
+		Name:   "erc20-mapping",
+		Desc:   "Comma-separated address:denom pairs that override the ERC20 to denom mapping read from Injective",
+		EnvVar: "PEGGO_ERC20_MAPPING",
+		Value:  "",
+	})
+
+	cfg.erc20MappingFile = cmd.String(cli.StringOpt{
+		Name:   "erc20-mapping-file",
+		Desc:   "Path to a JSON object of ERC20 addresses to denoms that override the mapping read from Injective",
+		EnvVar: "PEGGO_ERC20_MAPPING_FILE",
+		Value:  "",
+	})
+
 	/** Batch Requester **/
 
 	cfg.minBatchFeeUSD = cmd.Float64(cli.Float64Opt{
@@ -468,6 +842,55 @@ func initConfig(cmd *cli.Cmd) Config {
 		Value:  float64(23.3),
 	})
 
+	cfg.batchPolicy = cmd.String(cli.StringOpt{
+		Name:   "batch-policy",
+		Desc:   "Path to a JSON file with per-token batch request rules. Tokens without a rule use min_batch_fee_usd.",
+		EnvVar: "PEGGO_BATCH_POLICY",
+		Value:  "",
+	})
+
+	cfg.dynamicBatchFee = cmd.Bool(cli.BoolOpt{
+		Name:   "dynamic-batch-fee",
+		Desc:   "Raise the min batch fee to the current USD cost of relaying the batch to Ethereum. min_batch_fee_usd stays as a floor.",
+		EnvVar: "PEGGO_DYNAMIC_BATCH_FEE",
+		Value:  false,
+	})
+
+	cfg.dynamicBatchFeeMultiplier = cmd.Float64(cli.Float64Opt{
+		Name:   "dynamic-batch-fee-multiplier",
+		Desc:   "Multiplier applied to the relaying cost when computing the dynamic batch fee",
+		EnvVar: "PEGGO_DYNAMIC_BATCH_FEE_MULTIPLIER",
+		Value:  1.2,
+	})
+
+	cfg.batchBaseGas = cmd.Int(cli.IntOpt{
+		Name:   "batch-base-gas",
+		Desc:   "Estimated submitBatch gas that doesn't depend on the number of txs in the batch",
+		EnvVar: "PEGGO_BATCH_BASE_GAS",
+		Value:  500000,
+	})
+
+	cfg.batchGasPerTx = cmd.Int(cli.IntOpt{
+		Name:   "batch-gas-per-tx",
+		Desc:   "Estimated submitBatch gas of each tx in the batch",
+		EnvVar: "PEGGO_BATCH_GAS_PER_TX",
+		Value:  40000,
+	})
+
+	cfg.batchMaxTxs = cmd.Int(cli.IntOpt{
+		Name:   "batch-max-txs",
+		Desc:   "Max number of txs in a batch, used to estimate submitBatch gas",
+		EnvVar: "PEGGO_BATCH_MAX_TXS",
+		Value:  100,
+	})
+
+	cfg.wethAddress = cmd.String(cli.StringOpt{
+		Name:   "weth-address",
+		Desc:   "WETH token contract used to query the ETH price for the dynamic batch fee",
+		EnvVar: "PEGGO_WETH_ADDRESS",
+		Value:  "0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2",
+	})
+
 	/** Coingecko **/
 
 	cfg.coingeckoApi = cmd.String(cli.StringOpt{
@@ -477,5 +900,75 @@ func initConfig(cmd *cli.Cmd) Config {
 		Value:  "https://api.coingecko.com/api/v3",
 	})
 
+	/** Price feed **/
+
+	cfg.priceFeedCoingecko = cmd.Bool(cli.BoolOpt{
+		Name:   "price-feed-coingecko",
+		Desc:   "Use CoinGecko as a price source. Disable to rely on Chainlink and other configured sources only.",
+		EnvVar: "PEGGO_PRICE_FEED_COINGECKO",
+		Value:  true,
+	})
+
+	cfg.chainlinkFeeds = cmd.String(cli.StringOpt{
+		Name:   "chainlink-feeds",
+		Desc:   "Path to a JSON file mapping ERC20 tokens to Chainlink aggregators, enables on-chain Chainlink prices",
+		EnvVar: "PEGGO_CHAINLINK_FEEDS",
+		Value:  "",
+	})
+
+	cfg.priceFeedSources = cmd.String(cli.StringOpt{
+		Name:   "price-feed-sources",
+		Desc:   "Extra JSON price APIs queried along with CoinGecko, as comma-separated name|url|field entries. {contract} in the url and field is replaced by the token address.",
+		EnvVar: "PEGGO_PRICE_FEED_SOURCES",
+		Value:  "",
+	})
+
+	cfg.priceFeedCacheTTL = cmd.String(cli.StringOpt{
+		Name:   "price-feed-cache-ttl",
+		Desc:   "How long token prices are cached before price sources are queried again",
+		EnvVar: "PEGGO_PRICE_FEED_CACHE_TTL",
+		Value:  "1m",
+	})
+
+	cfg.priceFeedMaxStaleness = cmd.String(cli.StringOpt{
+		Name:   "price-feed-max-staleness",
+		Desc:   "Max age of the last known price used when all price sources fail",
+		EnvVar: "PEGGO_PRICE_FEED_MAX_STALENESS",
+		Value:  "30m",
+	})
+
+	cfg.priceFeedMaxDeviation = cmd.Float64(cli.Float64Opt{
+		Name:   "price-feed-max-deviation",
+		Desc:   "Prices that deviate from the median of all sources by more than this fraction are rejected, 0 disables the check",
+		EnvVar: "PEGGO_PRICE_FEED_MAX_DEVIATION",
+		Value:  0.1,
+	})
+
+	cfg.priceFeedPrimary = cmd.String(cli.StringOpt{
+		Name:   "price-feed-primary",
+		Desc:   "Price source trusted when only two sources answer and they disagree (coingecko, chainlink or a name from --price-feed-sources). Defaults to the first source.",
+		EnvVar: "PEGGO_PRICE_FEED_PRIMARY",
+		Value:  "",
+	})
+
 	return cfg
 }
+
+// initEthereumRPCOption defines the --eth-node-http option shared by every command talking to Ethereum.
+func initEthereumRPCOption(cmd *cli.Cmd) *string {
+	return cmd.String(cli.StringOpt{
+		Name:   "eth-node-http",
+		Desc:   "Specify HTTP endpoint for an Ethereum node.",
+		EnvVar: "PEGGO_ETH_RPC",
+		Value:  "http://localhost:8545",
+	})
+}
+
+func defaultNonceJournalPath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Join(home, ".peggo", "eth_nonces.json")
+}
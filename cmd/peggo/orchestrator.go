@@ -4,18 +4,28 @@ import (
 	"context"
 	"github.com/InjectiveLabs/peggo/orchestrator/version"
 	"os"
+	"strconv"
+	"strings"
 	"time"
 
 	ctypes "github.com/InjectiveLabs/sdk-go/chain/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	ethcmn "github.com/ethereum/go-ethereum/common"
 	cli "github.com/jawher/mow.cli"
+	"github.com/pkg/errors"
 	"github.com/xlab/closer"
 	log "github.com/xlab/suplog"
 
 	"github.com/InjectiveLabs/peggo/orchestrator"
+	"github.com/InjectiveLabs/peggo/orchestrator/alerts"
+	"github.com/InjectiveLabs/peggo/orchestrator/chainlink"
 	"github.com/InjectiveLabs/peggo/orchestrator/coingecko"
 	"github.com/InjectiveLabs/peggo/orchestrator/cosmos"
 	"github.com/InjectiveLabs/peggo/orchestrator/ethereum"
+	"github.com/InjectiveLabs/peggo/orchestrator/ethereum/committer"
+	"github.com/InjectiveLabs/peggo/orchestrator/ethereum/util"
+	"github.com/InjectiveLabs/peggo/orchestrator/health"
+	"github.com/InjectiveLabs/peggo/orchestrator/pricefeed"
 )
 
 // startOrchestrator action runs an infinite loop,
@@ -25,9 +35,11 @@ import (
 func orchestratorCmd(cmd *cli.Cmd) {
 	// orchestrator-specific CLI options
 	cfg := initConfig(cmd)
+	metricsOpts := initMetricsOptions(cmd)
+	alertOpts := initAlertingOptions(cmd)
 
 	cmd.Before = func() {
-		initMetrics(cmd)
+		initMetrics(metricsOpts)
 	}
 
 	cmd.Action = func() {
@@ -42,6 +54,10 @@ func orchestratorCmd(cmd *cli.Cmd) {
 			"go_arch":    version.GoArch,
 		}).Infoln("peggo - peggy binary for Ethereum bridge")
 
+		if err := initAlerts(alertOpts); err != nil {
+			log.WithError(err).Fatalln("failed to init alerts")
+		}
+
 		if *cfg.cosmosUseLedger || *cfg.ethUseLedger {
 			log.Fatalln("cannot use Ledger for peggo, since signatures must be realtime")
 		}
@@ -66,14 +82,54 @@ func orchestratorCmd(cmd *cli.Cmd) {
 			cfg.ethPassphrase,
 			cfg.ethPrivKey,
 			cfg.ethUseLedger,
+			cfg.ethRemoteSignerURL,
+			cfg.ethRemoteSignerAPI,
+			cfg.ethRemoteSignerCACert,
+			cfg.ethRemoteSignerClientCert,
+			cfg.ethRemoteSignerClientKey,
 		)
 		if err != nil {
 			log.WithError(err).Fatalln("failed to initialize Ethereum account")
 		}
 
+		// Relayed txs are paid by separate accounts when configured,
+		// so the orchestrator key doesn't have to hold ETH.
+		relayerAccounts := []committer.Account{{Address: ethKeyFromAddress, Signer: signerFn}}
+		if hasRelayerEthAccount(cfg) {
+			relayerAccounts, err = initRelayerEthAccounts(cfg)
+			if err != nil {
+				log.WithError(err).Fatalln("failed to initialize relayer Ethereum account")
+			}
+		}
+
+		relayerEthAddress := relayerAccounts[0].Address
+
+		minRelayerBalance, err := parseEthAmount(*cfg.relayerEthMinBalance)
+		if err != nil {
+			log.WithError(err).Fatalln("failed to parse relayer min balance")
+		}
+
+		nonceJournal, err := util.NewNonceJournal(*cfg.ethNonceJournal)
+		if err != nil {
+			log.WithError(err).WithField("path", *cfg.ethNonceJournal).
+				Warningln("failed to open Ethereum nonce journal, keeping it in memory only")
+			nonceJournal, _ = util.NewNonceJournal("")
+		}
+
+		stuckTxTimeout, err := time.ParseDuration(*cfg.ethStuckTxTimeout)
+		if err != nil {
+			log.WithError(err).Fatalln("failed to parse stuck tx timeout")
+		}
+
+		gasPricerOpts, err := initGasPricerOptions(cfg)
+		if err != nil {
+			log.WithError(err).Fatalln("failed to initialize Ethereum gas price strategies")
+		}
+
 		log.WithFields(log.Fields{
-			"inj_addr": valAddress.String(),
-			"eth_addr": ethKeyFromAddress.String(),
+			"inj_addr":         valAddress.String(),
+			"eth_addr":         ethKeyFromAddress.String(),
+			"relayer_eth_addr": relayerEthAddress.String(),
 		}).Infoln("starting peggo service")
 
 		// Connect to Injective network
@@ -107,31 +163,66 @@ func orchestratorCmd(cmd *cli.Cmd) {
 		peggyContractAddr := ethcmn.HexToAddress(peggyParams.BridgeEthereumAddress)
 		injTokenAddr := ethcmn.HexToAddress(peggyParams.CosmosCoinErc20Contract)
 
-		erc20ContractMapping := make(map[ethcmn.Address]string)
-		erc20ContractMapping[injTokenAddr] = ctypes.InjectiveCoin
+		erc20ContractMapping, err := initERC20ContractMapping(cfg, injTokenAddr)
+		if err != nil {
+			log.WithError(err).Fatalln("failed to initialize ERC20 token mapping")
+		}
+
+		committerOpts := append([]committer.EVMCommitterOption{
+			committer.OptionSenderPool(relayerAccounts[1:]...),
+			committer.OptionMinSenderBalance(minRelayerBalance),
+			committer.OptionNonceJournal(nonceJournal),
+			committer.OptionStuckTxTimeout(stuckTxTimeout),
+		}, gasPricerOpts...)
 
 		// Connect to ethereum network
 		ethNetwork, err := ethereum.NewNetwork(
 			*cfg.ethNodeRPC,
 			peggyContractAddr,
-			ethKeyFromAddress,
-			signerFn,
+			relayerEthAddress,
+			relayerAccounts[0].Signer,
 			*cfg.ethGasPriceAdjustment,
 			*cfg.ethMaxGasPrice,
 			*cfg.pendingTxWaitDuration,
 			*cfg.ethNodeAlchemyWS,
+			committerOpts...,
 		)
 		orShutdown(err)
 
-		coingeckoFeed := coingecko.NewCoingeckoPriceFeed(100, &coingecko.Config{BaseURL: *cfg.coingeckoApi})
+		var batchPolicy *orchestrator.BatchPolicy
+		if len(*cfg.batchPolicy) > 0 {
+			batchPolicy, err = orchestrator.LoadBatchPolicy(*cfg.batchPolicy)
+			if err != nil {
+				log.WithError(err).Fatalln("failed to load batch policy")
+			}
+		}
+
+		priceFeed, err := initPriceFeed(cfg, ethNetwork.Provider())
+		if err != nil {
+			log.WithError(err).Fatalln("failed to initialize price feed")
+		}
+
+		dynamicBatchFee, err := initDynamicBatchFee(cfg)
+		if err != nil {
+			log.WithError(err).Fatalln("failed to initialize dynamic batch fee")
+		}
+
+		balanceWatch, err := initBalanceWatch(cfg)
+		if err != nil {
+			log.WithError(err).Fatalln("failed to initialize relayer balance watch")
+		}
 
 		// Create peggo and run it
 		peggo, err := orchestrator.NewPeggyOrchestrator(
 			injNetwork,
 			ethNetwork,
-			coingeckoFeed,
-			erc20ContractMapping,
+			ethKeyFromAddress,
+			priceFeed,
+			orchestrator.NewTokenMapping(erc20ContractMapping),
 			*cfg.minBatchFeeUSD,
+			batchPolicy,
+			dynamicBatchFee,
+			balanceWatch,
 			*cfg.relayValsets,
 			*cfg.relayBatches,
 			*cfg.relayValsetOffsetDur,
@@ -139,9 +230,23 @@ func orchestratorCmd(cmd *cli.Cmd) {
 		)
 		orShutdown(err)
 
+		metricsOpts.health.SetEndpoints(
+			health.Endpoint{Name: "injective_grpc", Check: injNetwork.CheckGRPC},
+			health.Endpoint{Name: "tendermint_rpc", Check: func(ctx context.Context) error {
+				_, err := injNetwork.GetLatestBlockHeight(ctx)
+				return err
+			}},
+			health.Endpoint{Name: "ethereum_rpc", Check: func(ctx context.Context) error {
+				_, err := ethNetwork.HeaderByNumber(ctx, nil)
+				return err
+			}},
+		)
+
 		go func() {
 			if err := peggo.Run(ctx, isValidator); err != nil {
 				log.Errorln(err)
+				// os.Exit skips the closer, flush the alerts about the failure first
+				alerts.Close()
 				os.Exit(1)
 			}
 		}()
@@ -150,6 +255,248 @@ func orchestratorCmd(cmd *cli.Cmd) {
 	}
 }
 
+// initERC20ContractMapping returns the static part of the token mapping: INJ, then
+// the mapping file and the --erc20-mapping pairs, each overriding the previous ones.
+// Other Cosmos-originated tokens are read from Injective at runtime.
+func initERC20ContractMapping(cfg Config, injTokenAddr ethcmn.Address) (map[ethcmn.Address]string, error) {
+	mapping := map[ethcmn.Address]string{
+		injTokenAddr: ctypes.InjectiveCoin,
+	}
+
+	if len(*cfg.erc20MappingFile) > 0 {
+		fileMapping, err := loadERC20ContractMapping(*cfg.erc20MappingFile)
+		if err != nil {
+			return nil, err
+		}
+
+		for addr, denom := range fileMapping {
+			mapping[addr] = denom
+		}
+	}
+
+	flagMapping, err := parseERC20ContractMapping(splitList(*cfg.erc20Mapping))
+	if err != nil {
+		return nil, err
+	}
+
+	for addr, denom := range flagMapping {
+		mapping[addr] = denom
+	}
+
+	return mapping, nil
+}
+
+// initPriceFeed aggregates CoinGecko, Chainlink and the extra price sources configured by --price-feed-sources.
+func initPriceFeed(cfg Config, ethCaller bind.ContractCaller) (*pricefeed.AggregatedPriceFeed, error) {
+	cacheTTL, err := time.ParseDuration(*cfg.priceFeedCacheTTL)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse price feed cache TTL")
+	}
+
+	maxStaleness, err := time.ParseDuration(*cfg.priceFeedMaxStaleness)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse price feed max staleness")
+	}
+
+	var sources []pricefeed.Source
+	if *cfg.priceFeedCoingecko {
+		sources = append(sources, pricefeed.Source{
+			Name:        "coingecko",
+			PriceSource: coingecko.NewCoingeckoPriceFeed(100, &coingecko.Config{BaseURL: *cfg.coingeckoApi}),
+		})
+	}
+
+	if len(*cfg.chainlinkFeeds) > 0 {
+		chainlinkCfg, err := chainlink.LoadConfig(*cfg.chainlinkFeeds)
+		if err != nil {
+			return nil, err
+		}
+
+		chainlinkFeed, err := chainlink.NewChainlinkPriceFeed(ethCaller, chainlinkCfg)
+		if err != nil {
+			return nil, err
+		}
+
+		sources = append(sources, pricefeed.Source{Name: "chainlink", PriceSource: chainlinkFeed})
+	}
+
+	for _, item := range splitList(*cfg.priceFeedSources) {
+		parts := strings.Split(item, "|")
+		if len(parts) != 3 {
+			return nil, errors.Errorf("invalid price source %s, expected name|url|field", item)
+		}
+
+		sources = append(sources, pricefeed.Source{
+			Name:        parts[0],
+			PriceSource: pricefeed.NewHTTPSource(parts[1], parts[2]),
+		})
+	}
+
+	return pricefeed.NewAggregatedPriceFeed(&pricefeed.Config{
+		CacheTTL:     cacheTTL,
+		MaxStaleness: maxStaleness,
+		MaxDeviation: *cfg.priceFeedMaxDeviation,
+		Primary:      *cfg.priceFeedPrimary,
+	}, sources...)
+}
+
+// initDynamicBatchFee returns nil unless --dynamic-batch-fee is set.
+func initDynamicBatchFee(cfg Config) (*orchestrator.DynamicBatchFee, error) {
+	if !*cfg.dynamicBatchFee {
+		return nil, nil
+	}
+
+	if !ethcmn.IsHexAddress(*cfg.wethAddress) {
+		return nil, errors.Errorf("invalid WETH address %s", *cfg.wethAddress)
+	} else if *cfg.batchBaseGas < 0 || *cfg.batchGasPerTx < 0 || *cfg.batchMaxTxs < 1 {
+		return nil, errors.New("batch gas estimates must not be negative and batch max txs must be positive")
+	} else if *cfg.dynamicBatchFeeMultiplier <= 0 {
+		return nil, errors.New("dynamic batch fee multiplier must be positive")
+	}
+
+	return &orchestrator.DynamicBatchFee{
+		BaseGas:     uint64(*cfg.batchBaseGas),
+		GasPerTx:    uint64(*cfg.batchGasPerTx),
+		MaxTxs:      *cfg.batchMaxTxs,
+		Multiplier:  *cfg.dynamicBatchFeeMultiplier,
+		WETHAddress: ethcmn.HexToAddress(*cfg.wethAddress),
+	}, nil
+}
+
+// initBalanceWatch configures the relayer account balance watcher, nil if disabled.
+func initBalanceWatch(cfg Config) (*orchestrator.BalanceWatch, error) {
+	if !*cfg.relayerBalanceWatch {
+		return nil, nil
+	}
+
+	minBalance, err := parseEthAmount(*cfg.relayerBalanceFloor)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid relayer balance floor")
+	}
+
+	var warnRelays []uint64
+	for _, s := range strings.Split(*cfg.relayerBalanceWarnRelays, ",") {
+		if s = strings.TrimSpace(s); s == "" {
+			continue
+		}
+
+		n, err := strconv.ParseUint(s, 10, 64)
+		if err != nil || n == 0 {
+			return nil, errors.Errorf("invalid number of relays to warn at: %s", s)
+		}
+
+		warnRelays = append(warnRelays, n)
+	}
+
+	return &orchestrator.BalanceWatch{
+		WarnRelays: warnRelays,
+		MinBalance: minBalance,
+	}, nil
+}
+
+// initGasPricerOptions configures the gas price strategies of valset updates and batches.
+func initGasPricerOptions(cfg Config) ([]committer.EVMCommitterOption, error) {
+	pricerCfg := committer.GasPricerConfig{
+		FeeHistoryBlocks:     uint64(*cfg.ethFeeHistoryBlocks),
+		FeeHistoryPercentile: *cfg.ethFeeHistoryPercentile,
+		GasStationURL:        *cfg.ethGasStationURL,
+		GasStationField:      *cfg.ethGasStationField,
+	}
+
+	if *cfg.ethFeeHistoryBlocks < 0 {
+		return nil, errors.New("fee history block count must not be negative")
+	}
+
+	if len(*cfg.ethFixedGasPrice) > 0 {
+		fixedGasPrice, err := committer.ParseGasPrice(*cfg.ethFixedGasPrice)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse fixed gas price")
+		}
+
+		pricerCfg.FixedGasPrice = fixedGasPrice
+	}
+
+	valsetCfg := pricerCfg
+	valsetCfg.Strategy = *cfg.ethGasPriceStrategyValset
+
+	batchCfg := pricerCfg
+	batchCfg.Strategy = *cfg.ethGasPriceStrategyBatch
+
+	opts := []committer.EVMCommitterOption{
+		committer.OptionGasPricer(committer.TxKindValsetUpdate, valsetCfg),
+		committer.OptionGasPricer(committer.TxKindBatch, batchCfg),
+	}
+
+	if *cfg.ethGasUrgencyBlocks < 0 {
+		return nil, errors.New("gas urgency block count must not be negative")
+	} else if *cfg.ethGasUrgencyBlocks > 0 {
+		opts = append(opts, committer.OptionGasPriceUrgency(uint64(*cfg.ethGasUrgencyBlocks), *cfg.ethGasUrgencyMaxMultiplier))
+	}
+
+	return opts, nil
+}
+
+func hasRelayerEthAccount(cfg Config) bool {
+	return len(*cfg.relayerEthKeystoreDir) > 0 ||
+		len(*cfg.relayerEthPrivKey) > 0 ||
+		len(*cfg.relayerEthRemoteSignerURL) > 0
+}
+
+// initRelayerEthAccounts loads the relayer sender accounts. Both --relayer-eth-from
+// and --relayer-eth-pk accept comma-separated lists to set up a pool of senders.
+func initRelayerEthAccounts(cfg Config) ([]committer.Account, error) {
+	var (
+		addresses = splitList(*cfg.relayerEthKeyFrom)
+		privKeys  = splitList(*cfg.relayerEthPrivKey)
+		noLedger  = false
+		accounts  []committer.Account
+	)
+
+	loadAccount := func(from, pk string) error {
+		addr, signerFn, _, err := initEthereumAccountsManager(
+			uint64(*cfg.ethChainID),
+			cfg.relayerEthKeystoreDir,
+			&from,
+			cfg.relayerEthPassphrase,
+			&pk,
+			&noLedger,
+			cfg.relayerEthRemoteSignerURL,
+			cfg.ethRemoteSignerAPI,
+			cfg.ethRemoteSignerCACert,
+			cfg.ethRemoteSignerClientCert,
+			cfg.ethRemoteSignerClientKey,
+		)
+		if err != nil {
+			return err
+		}
+
+		accounts = append(accounts, committer.Account{Address: addr, Signer: signerFn})
+		return nil
+	}
+
+	if len(privKeys) > 0 {
+		for _, pk := range privKeys {
+			if err := loadAccount("", pk); err != nil {
+				return nil, err
+			}
+		}
+
+		return accounts, nil
+	}
+
+	if len(addresses) == 0 {
+		return nil, errors.New("relayer account address must be specified")
+	}
+
+	for _, from := range addresses {
+		if err := loadAccount(from, ""); err != nil {
+			return nil, errors.Wrapf(err, "failed to load relayer account %s", from)
+		}
+	}
+
+	return accounts, nil
+}
+
 func isValidatorAddress(peggyQuery cosmos.PeggyQueryClient, addr ethcmn.Address) (bool, error) {
 	ctx, cancelFn := context.WithTimeout(context.Background(), time.Second*30)
 	defer cancelFn()
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	cli "github.com/jawher/mow.cli"
+	"github.com/pkg/errors"
+)
+
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+)
+
+func initOutputOptions(
+	cmd *cli.Cmd,
+	output **string,
+) {
+	*output = cmd.String(cli.StringOpt{
+		Name:   "o output",
+		Desc:   "Output format (table|json)",
+		EnvVar: "PEGGO_OUTPUT",
+		Value:  outputTable,
+	})
+}
+
+// printOutput writes v to stdout as indented JSON, or calls printTable
+// with a tab-aligned writer when the table format is selected.
+func printOutput(format string, v interface{}, printTable func(w io.Writer)) error {
+	switch strings.ToLower(strings.TrimSpace(format)) {
+	case outputJSON:
+		data, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return errors.Wrap(err, "failed to encode output as JSON")
+		}
+
+		fmt.Println(string(data))
+		return nil
+	case outputTable, "":
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		printTable(w)
+		return w.Flush()
+	default:
+		return errors.Errorf("unknown output format %s, expected table or json", format)
+	}
+}
+
+// printRow writes tab-separated columns as one table row.
+func printRow(w io.Writer, columns ...interface{}) {
+	for i, col := range columns {
+		if i > 0 {
+			fmt.Fprint(w, "\t")
+		}
+
+		fmt.Fprint(w, col)
+	}
+
+	fmt.Fprintln(w)
+}
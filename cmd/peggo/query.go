@@ -1,11 +1,508 @@
 package main
 
-import cli "github.com/jawher/mow.cli"
+import (
+	"context"
+	"io"
+	"math/big"
+	"strconv"
+	"time"
+
+	cosmtypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	cli "github.com/jawher/mow.cli"
+	"github.com/pkg/errors"
+	log "github.com/xlab/suplog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/InjectiveLabs/sdk-go/chain/peggy/types"
+
+	"github.com/InjectiveLabs/peggo/orchestrator/cosmos"
+	wrappers "github.com/InjectiveLabs/peggo/solidity/wrappers/Peggy.sol"
+)
+
+// queryTimeout bounds a single query command, including the connection to the node.
+const queryTimeout = time.Minute
 
 // queryCmdSubset contains actions that query stuff from Peggy module
 // and the Ethereum contract
 //
 // $ peggo q
 func queryCmdSubset(cmd *cli.Cmd) {
+	cmd.Command("current-valset", "Shows the current validator set of the Peggy module", queryCurrentValsetCmd)
+	cmd.Command("pending-valsets", "Shows the latest valset requests, or the ones not yet signed by an orchestrator", queryPendingValsetsCmd)
+	cmd.Command("batches", "Shows the outgoing tx batches waiting to be relayed", queryBatchesCmd)
+	cmd.Command("batch-confirms", "Shows the orchestrator confirmations of an outgoing batch", queryBatchConfirmsCmd)
+	cmd.Command("unbatched-fees", "Shows the total fees of queued withdrawals per token", queryUnbatchedFeesCmd)
+	cmd.Command("last-claim", "Shows the last Ethereum event claimed by an orchestrator", queryLastClaimCmd)
+	cmd.Command("params", "Shows the Peggy module params", queryParamsCmd)
+	cmd.Command("contract", "Shows the nonces and peggyID stored in the Peggy contract", queryContractCmd)
+}
+
+func initQueryOptions(
+	cmd *cli.Cmd,
+	cosmosGRPC **string,
+	output **string,
+) {
+	*cosmosGRPC = cmd.String(cli.StringOpt{
+		Name:   "cosmos-grpc",
+		Desc:   "Cosmos GRPC querying endpoint",
+		EnvVar: "PEGGO_COSMOS_GRPC",
+		Value:  "tcp://localhost:9900",
+	})
+
+	initOutputOptions(cmd, output)
+}
+
+// dialPeggyQueryClient connects to the GRPC endpoint of Injective. Queries don't need
+// a keyring, so the connection is made directly instead of through the chain client.
+func dialPeggyQueryClient(ctx context.Context, cosmosGRPC string) (cosmos.PeggyQueryClient, error) {
+	conn, err := grpc.DialContext(ctx, cosmosGRPC,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(cosmos.DialerFunc),
+		grpc.WithBlock(),
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to connect to Injective GRPC %s", cosmosGRPC)
+	}
+
+	return cosmos.NewPeggyQueryClient(types.NewQueryClient(conn)), nil
+}
+
+// peggyReader reads the state of the Peggy contract. It's used by the read-only
+// commands, which need neither a committer nor a signer.
+type peggyReader struct {
+	address ethcmn.Address
+	caller  *wrappers.PeggyCaller
+}
+
+func newPeggyReader(peggyAddr ethcmn.Address, backend bind.ContractCaller) (*peggyReader, error) {
+	caller, err := wrappers.NewPeggyCaller(peggyAddr, backend)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to init Peggy caller")
+	}
+
+	return &peggyReader{address: peggyAddr, caller: caller}, nil
+}
+
+// dialPeggyReader connects to the Ethereum node to read the Peggy contract.
+func dialPeggyReader(ctx context.Context, ethNodeRPC string, peggyAddr ethcmn.Address) (*peggyReader, error) {
+	ethClient, err := ethclient.DialContext(ctx, ethNodeRPC)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to connect to Ethereum RPC %s", ethNodeRPC)
+	}
+
+	return newPeggyReader(peggyAddr, ethClient)
+}
+
+func (r *peggyReader) Address() ethcmn.Address {
+	return r.address
+}
+
+func (r *peggyReader) GetPeggyID(ctx context.Context) (ethcmn.Hash, error) {
+	peggyID, err := r.caller.StatePeggyId(&bind.CallOpts{Context: ctx})
+	return ethcmn.Hash(peggyID), err
+}
+
+func (r *peggyReader) GetValsetNonce(ctx context.Context) (*big.Int, error) {
+	return r.caller.StateLastValsetNonce(&bind.CallOpts{Context: ctx})
+}
+
+func (r *peggyReader) GetValsetCheckpoint(ctx context.Context) (ethcmn.Hash, error) {
+	checkpoint, err := r.caller.StateLastValsetCheckpoint(&bind.CallOpts{Context: ctx})
+	return ethcmn.Hash(checkpoint), err
+}
+
+func (r *peggyReader) GetLastEventNonce(ctx context.Context) (*big.Int, error) {
+	return r.caller.StateLastEventNonce(&bind.CallOpts{Context: ctx})
+}
+
+func (r *peggyReader) GetTxBatchNonce(ctx context.Context, erc20ContractAddress ethcmn.Address) (*big.Int, error) {
+	return r.caller.LastBatchNonce(&bind.CallOpts{Context: ctx}, erc20ContractAddress)
+}
+
+// runQuery connects to Injective and runs the query, exiting on any error.
+func runQuery(cosmosGRPC string, queryFn func(ctx context.Context, peggyQuery cosmos.PeggyQueryClient) error) {
+	ctx, cancelFn := context.WithTimeout(context.Background(), queryTimeout)
+	defer cancelFn()
+
+	peggyQuery, err := dialPeggyQueryClient(ctx, cosmosGRPC)
+	if err != nil {
+		log.WithError(err).Fatalln("failed to connect to Injective")
+	}
+
+	if err := queryFn(ctx, peggyQuery); err != nil {
+		log.WithError(err).Fatalln("query failed")
+	}
+}
+
+func queryCurrentValsetCmd(cmd *cli.Cmd) {
+	var cosmosGRPC, output *string
+	initQueryOptions(cmd, &cosmosGRPC, &output)
+
+	cmd.Action = func() {
+		runQuery(*cosmosGRPC, func(ctx context.Context, peggyQuery cosmos.PeggyQueryClient) error {
+			valset, err := peggyQuery.CurrentValset(ctx)
+			if err != nil {
+				return err
+			}
+
+			return printOutput(*output, valset, func(w io.Writer) {
+				printValsetMembers(w, valset)
+			})
+		})
+	}
+}
+
+func queryPendingValsetsCmd(cmd *cli.Cmd) {
+	var cosmosGRPC, output *string
+	initQueryOptions(cmd, &cosmosGRPC, &output)
+
+	address := cmd.String(cli.StringOpt{
+		Name: "address",
+		Desc: "Show only the valsets not yet signed by this orchestrator (inj1... account address)",
+	})
+
+	cmd.Action = func() {
+		runQuery(*cosmosGRPC, func(ctx context.Context, peggyQuery cosmos.PeggyQueryClient) error {
+			var (
+				valsets []*types.Valset
+				err     error
+			)
+
+			if len(*address) > 0 {
+				accAddress, addrErr := cosmtypes.AccAddressFromBech32(*address)
+				if addrErr != nil {
+					return errors.Wrap(addrErr, "invalid orchestrator address")
+				}
+
+				valsets, err = peggyQuery.OldestUnsignedValsets(ctx, accAddress)
+			} else {
+				valsets, err = peggyQuery.LatestValsets(ctx)
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return printOutput(*output, valsets, func(w io.Writer) {
+				printRow(w, "NONCE", "HEIGHT", "MEMBERS", "REWARD")
+				for _, valset := range valsets {
+					printRow(w, valset.Nonce, valset.Height, len(valset.Members), valset.RewardAmount.String()+" "+valset.RewardToken)
+				}
+			})
+		})
+	}
+}
+
+func queryBatchesCmd(cmd *cli.Cmd) {
+	var cosmosGRPC, output *string
+	initQueryOptions(cmd, &cosmosGRPC, &output)
+
+	cmd.Action = func() {
+		runQuery(*cosmosGRPC, func(ctx context.Context, peggyQuery cosmos.PeggyQueryClient) error {
+			batches, err := peggyQuery.LatestTransactionBatches(ctx)
+			if err != nil {
+				return err
+			}
+
+			return printOutput(*output, batches, func(w io.Writer) {
+				printRow(w, "NONCE", "TOKEN", "TXS", "TOTAL FEE", "TIMEOUT", "BLOCK")
+				for _, batch := range batches {
+					printRow(w, batch.BatchNonce, batch.TokenContract, len(batch.Transactions), batchTotalFee(batch), batch.BatchTimeout, batch.Block)
+				}
+			})
+		})
+	}
+}
+
+func queryBatchConfirmsCmd(cmd *cli.Cmd) {
+	var cosmosGRPC, output *string
+	initQueryOptions(cmd, &cosmosGRPC, &output)
+
+	cmd.Spec = "[OPTIONS] NONCE TOKEN"
+	nonce := cmd.StringArg("NONCE", "", "Nonce of the batch")
+	token := cmd.StringArg("TOKEN", "", "ERC20 contract address of the batch")
+
+	cmd.Action = func() {
+		batchNonce, err := strconv.ParseUint(*nonce, 10, 64)
+		if err != nil {
+			log.WithError(err).Fatalln("invalid batch nonce")
+		}
+
+		if !ethcmn.IsHexAddress(*token) {
+			log.WithField("token", *token).Fatalln("invalid token contract address")
+		}
+
+		runQuery(*cosmosGRPC, func(ctx context.Context, peggyQuery cosmos.PeggyQueryClient) error {
+			confirms, err := peggyQuery.TransactionBatchSignatures(ctx, batchNonce, ethcmn.HexToAddress(*token))
+			if err != nil {
+				return err
+			}
+
+			return printOutput(*output, confirms, func(w io.Writer) {
+				printRow(w, "ORCHESTRATOR", "ETH SIGNER", "SIGNATURE")
+				for _, confirm := range confirms {
+					printRow(w, confirm.Orchestrator, confirm.EthSigner, confirm.Signature)
+				}
+			})
+		})
+	}
+}
+
+func queryUnbatchedFeesCmd(cmd *cli.Cmd) {
+	var cosmosGRPC, output *string
+	initQueryOptions(cmd, &cosmosGRPC, &output)
+
+	cmd.Action = func() {
+		runQuery(*cosmosGRPC, func(ctx context.Context, peggyQuery cosmos.PeggyQueryClient) error {
+			fees, err := peggyQuery.UnbatchedTokensWithFees(ctx)
+			if err != nil {
+				return err
+			}
+
+			return printOutput(*output, fees, func(w io.Writer) {
+				printRow(w, "TOKEN", "TOTAL FEES")
+				for _, fee := range fees {
+					printRow(w, fee.Token, fee.TotalFees.String())
+				}
+			})
+		})
+	}
+}
+
+func queryLastClaimCmd(cmd *cli.Cmd) {
+	var cosmosGRPC, output *string
+	initQueryOptions(cmd, &cosmosGRPC, &output)
+
+	cmd.Spec = "[OPTIONS] ADDRESS"
+	address := cmd.StringArg("ADDRESS", "", "Orchestrator account address (inj1...)")
+
+	cmd.Action = func() {
+		accAddress, err := cosmtypes.AccAddressFromBech32(*address)
+		if err != nil {
+			log.WithError(err).Fatalln("invalid orchestrator address")
+		}
+
+		runQuery(*cosmosGRPC, func(ctx context.Context, peggyQuery cosmos.PeggyQueryClient) error {
+			claim, err := peggyQuery.LastClaimEventByAddr(ctx, accAddress)
+			if err != nil {
+				return err
+			}
+
+			return printOutput(*output, claim, func(w io.Writer) {
+				printRow(w, "EVENT NONCE", "EVENT HEIGHT")
+				printRow(w, claim.EthereumEventNonce, claim.EthereumEventHeight)
+			})
+		})
+	}
+}
+
+func queryParamsCmd(cmd *cli.Cmd) {
+	var cosmosGRPC, output *string
+	initQueryOptions(cmd, &cosmosGRPC, &output)
+
+	cmd.Action = func() {
+		runQuery(*cosmosGRPC, func(ctx context.Context, peggyQuery cosmos.PeggyQueryClient) error {
+			params, err := peggyQuery.PeggyParams(ctx)
+			if err != nil {
+				return err
+			}
+
+			return printOutput(*output, params, func(w io.Writer) {
+				printRow(w, "peggy_id", params.PeggyId)
+				printRow(w, "bridge_ethereum_address", params.BridgeEthereumAddress)
+				printRow(w, "bridge_chain_id", params.BridgeChainId)
+				printRow(w, "bridge_contract_start_height", params.BridgeContractStartHeight)
+				printRow(w, "cosmos_coin_denom", params.CosmosCoinDenom)
+				printRow(w, "cosmos_coin_erc20_contract", params.CosmosCoinErc20Contract)
+				printRow(w, "signed_valsets_window", params.SignedValsetsWindow)
+				printRow(w, "signed_batches_window", params.SignedBatchesWindow)
+				printRow(w, "signed_claims_window", params.SignedClaimsWindow)
+				printRow(w, "target_batch_timeout", params.TargetBatchTimeout)
+			})
+		})
+	}
+}
+
+// contractState is the state of the Peggy contract on Ethereum, as seen by the query command.
+type contractState struct {
+	Address     string            `json:"address"`
+	PeggyID     string            `json:"peggy_id"`
+	ValsetNonce uint64            `json:"valset_nonce"`
+	EventNonce  uint64            `json:"event_nonce"`
+	BatchNonces map[string]uint64 `json:"batch_nonces"`
+}
+
+func queryContractCmd(cmd *cli.Cmd) {
+	var cosmosGRPC, output *string
+	initQueryOptions(cmd, &cosmosGRPC, &output)
+
+	ethNodeRPC := initEthereumRPCOption(cmd)
+
+	peggyContract := cmd.String(cli.StringOpt{
+		Name:   "peggy-contract",
+		Desc:   "Address of the Peggy contract, read from the Peggy module params if not set",
+		EnvVar: "PEGGO_PEGGY_CONTRACT",
+	})
+
+	tokens := cmd.String(cli.StringOpt{
+		Name: "tokens",
+		Desc: "Comma-separated ERC20 addresses to show batch nonces for, defaults to the Cosmos coin and the tokens with queued withdrawals or outgoing batches",
+	})
+
+	cmd.Action = func() {
+		runQuery(*cosmosGRPC, func(ctx context.Context, peggyQuery cosmos.PeggyQueryClient) error {
+			peggyAddr, tokenAddrs, err := contractQueryTargets(ctx, peggyQuery, *peggyContract, splitList(*tokens))
+			if err != nil {
+				return err
+			}
+
+			peggyReader, err := dialPeggyReader(ctx, *ethNodeRPC, peggyAddr)
+			if err != nil {
+				return err
+			}
+
+			state, err := getContractState(ctx, peggyReader, tokenAddrs)
+			if err != nil {
+				return err
+			}
+
+			return printOutput(*output, state, func(w io.Writer) {
+				printRow(w, "address", state.Address)
+				printRow(w, "peggy_id", state.PeggyID)
+				printRow(w, "valset_nonce", state.ValsetNonce)
+				printRow(w, "event_nonce", state.EventNonce)
+				for _, token := range tokenAddrs {
+					printRow(w, "batch_nonce "+token.Hex(), state.BatchNonces[token.Hex()])
+				}
+			})
+		})
+	}
+}
+
+// contractQueryTargets resolves the Peggy contract and tokens to query, falling back to the Peggy module state.
+func contractQueryTargets(
+	ctx context.Context,
+	peggyQuery cosmos.PeggyQueryClient,
+	peggyContract string,
+	tokens []string,
+) (ethcmn.Address, []ethcmn.Address, error) {
+	var params *types.Params
+	if len(peggyContract) == 0 || len(tokens) == 0 {
+		var err error
+		if params, err = peggyQuery.PeggyParams(ctx); err != nil {
+			return ethcmn.Address{}, nil, err
+		}
+	}
+
+	if len(peggyContract) == 0 {
+		peggyContract = params.BridgeEthereumAddress
+	}
+
+	if !ethcmn.IsHexAddress(peggyContract) {
+		return ethcmn.Address{}, nil, errors.Errorf("invalid Peggy contract address: %s", peggyContract)
+	}
+
+	var tokenAddrs []ethcmn.Address
+	for _, token := range tokens {
+		if !ethcmn.IsHexAddress(token) {
+			return ethcmn.Address{}, nil, errors.Errorf("invalid token address: %s", token)
+		}
+
+		tokenAddrs = append(tokenAddrs, ethcmn.HexToAddress(token))
+	}
+
+	if len(tokens) == 0 {
+		// there's no listing of every bridged token, so the default is the tokens with pending work
+		fees, err := peggyQuery.UnbatchedTokensWithFees(ctx)
+		if err != nil {
+			return ethcmn.Address{}, nil, err
+		}
+
+		batches, err := peggyQuery.LatestTransactionBatches(ctx)
+		if err != nil {
+			return ethcmn.Address{}, nil, err
+		}
+
+		seen := make(map[ethcmn.Address]bool)
+		addToken := func(token string) {
+			if addr := ethcmn.HexToAddress(token); !seen[addr] {
+				seen[addr] = true
+				tokenAddrs = append(tokenAddrs, addr)
+			}
+		}
+
+		addToken(params.CosmosCoinErc20Contract)
+		for _, fee := range fees {
+			addToken(fee.Token)
+		}
+
+		for _, batch := range batches {
+			addToken(batch.TokenContract)
+		}
+	}
+
+	return ethcmn.HexToAddress(peggyContract), tokenAddrs, nil
+}
+
+func getContractState(ctx context.Context, peggyReader *peggyReader, tokens []ethcmn.Address) (*contractState, error) {
+	peggyID, err := peggyReader.GetPeggyID(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get peggyID")
+	}
+
+	valsetNonce, err := peggyReader.GetValsetNonce(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get valset nonce")
+	}
+
+	eventNonce, err := peggyReader.GetLastEventNonce(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get event nonce")
+	}
+
+	state := &contractState{
+		Address:     peggyReader.Address().Hex(),
+		PeggyID:     peggyID.Hex(),
+		ValsetNonce: valsetNonce.Uint64(),
+		EventNonce:  eventNonce.Uint64(),
+		BatchNonces: make(map[string]uint64, len(tokens)),
+	}
+
+	for _, token := range tokens {
+		batchNonce, err := peggyReader.GetTxBatchNonce(ctx, token)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get batch nonce of token %s", token.Hex())
+		}
+
+		state.BatchNonces[token.Hex()] = batchNonce.Uint64()
+	}
+
+	return state, nil
+}
+
+func printValsetMembers(w io.Writer, valset *types.Valset) {
+	printRow(w, "NONCE", valset.Nonce)
+	printRow(w, "HEIGHT", valset.Height)
+	printRow(w)
+	printRow(w, "ETHEREUM ADDRESS", "POWER")
+	for _, member := range valset.Members {
+		printRow(w, member.EthereumAddress, member.Power)
+	}
+}
+
+func batchTotalFee(batch *types.OutgoingTxBatch) string {
+	if len(batch.Transactions) == 0 {
+		return "0"
+	}
+
+	total := batch.Transactions[0].Erc20Fee.Amount
+	for _, tx := range batch.Transactions[1:] {
+		total = total.Add(tx.Erc20Fee.Amount)
+	}
 
+	return total.String()
 }
@@ -2,9 +2,18 @@ package main
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	cosmtypes "github.com/cosmos/cosmos-sdk/types"
+	ethgo "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/connectivity"
 
@@ -17,7 +26,12 @@ import (
 	"github.com/InjectiveLabs/sdk-go/client/common"
 
 
+	"github.com/InjectiveLabs/peggo/orchestrator"
 	"github.com/InjectiveLabs/peggo/orchestrator/cosmos"
+	"github.com/InjectiveLabs/peggo/orchestrator/ethereum"
+	"github.com/InjectiveLabs/peggo/orchestrator/ethereum/peggy"
+	"github.com/InjectiveLabs/peggo/orchestrator/ethereum/provider"
+	wrappers "github.com/InjectiveLabs/peggo/solidity/wrappers/Peggy.sol"
 )
 
 // txCmdSubset contains actions that can sign and send messages to Cosmos module
@@ -30,6 +44,36 @@ func txCmdSubset(cmd *cli.Cmd) {
 		"Submits an Ethereum key that will be used to sign messages on behalf of your Validator",
 		registerEthKeyCmd,
 	)
+
+	cmd.Command(
+		"send-to-injective",
+		"Deposits ERC20 tokens to an Injective account through the Peggy contract",
+		sendToInjectiveCmd,
+	)
+
+	cmd.Command(
+		"send-to-eth",
+		"Withdraws tokens from Injective to an Ethereum address, paying a bridge fee to the relayer",
+		sendToEthCmd,
+	)
+
+	cmd.Command(
+		"request-batch",
+		"Requests a batch of the queued withdrawals of a denom",
+		requestBatchCmd,
+	)
+
+	cmd.Command(
+		"relay-batch",
+		"Relays a confirmed batch to the Peggy contract on Ethereum",
+		relayBatchCmd,
+	)
+
+	cmd.Command(
+		"relay-valset",
+		"Relays a confirmed valset update to the Peggy contract on Ethereum",
+		relayValsetCmd,
+	)
 }
 
 func registerEthKeyCmd(cmd *cli.Cmd) {
@@ -57,6 +101,12 @@ func registerEthKeyCmd(cmd *cli.Cmd) {
 		ethPrivKey     *string
 		ethUseLedger   *bool
 
+		ethRemoteSignerURL        *string
+		ethRemoteSignerAPI        *string
+		ethRemoteSignerCACert     *string
+		ethRemoteSignerClientCert *string
+		ethRemoteSignerClientKey  *string
+
 		// Misc
 		alwaysAutoConfirm *bool
 	)
@@ -87,6 +137,11 @@ func registerEthKeyCmd(cmd *cli.Cmd) {
 		&ethPassphrase,
 		&ethPrivKey,
 		&ethUseLedger,
+		&ethRemoteSignerURL,
+		&ethRemoteSignerAPI,
+		&ethRemoteSignerCACert,
+		&ethRemoteSignerClientCert,
+		&ethRemoteSignerClientKey,
 	)
 
 	initInteractiveOptions(
@@ -122,6 +177,11 @@ func registerEthKeyCmd(cmd *cli.Cmd) {
 			ethPassphrase,
 			ethPrivKey,
 			ethUseLedger,
+			ethRemoteSignerURL,
+			ethRemoteSignerAPI,
+			ethRemoteSignerCACert,
+			ethRemoteSignerClientCert,
+			ethRemoteSignerClientKey,
 		)
 		if err != nil {
 			log.WithError(err).Fatalln("failed to init Ethereum account")
@@ -183,6 +243,1122 @@ func registerEthKeyCmd(cmd *cli.Cmd) {
 	}
 }
 
+func sendToInjectiveCmd(cmd *cli.Cmd) {
+	txOpts := initEthTxOptions(cmd)
+
+	token := cmd.String(cli.StringOpt{
+		Name: "token",
+		Desc: "ERC20 contract address of the deposited token",
+	})
+
+	amount := cmd.String(cli.StringOpt{
+		Name: "amount",
+		Desc: "Amount to deposit in whole tokens, e.g. 1.5",
+	})
+
+	to := cmd.String(cli.StringOpt{
+		Name: "to",
+		Desc: "Injective account receiving the deposit (inj1...)",
+	})
+
+	waitObserved := cmd.Bool(cli.BoolOpt{
+		Name:  "wait-observed",
+		Desc:  "Wait until the deposit is observed on Injective",
+		Value: false,
+	})
+
+	timeout := cmd.String(cli.StringOpt{
+		Name:  "timeout",
+		Desc:  "How long to wait for the deposit to be mined and observed",
+		Value: "10m",
+	})
+
+	cmd.Action = func() {
+		// ensure a clean exit
+		defer closer.Close()
+
+		if !ethcmn.IsHexAddress(*token) {
+			log.WithField("token", *token).Fatalln("invalid token contract address")
+		}
+
+		tokenAddr := ethcmn.HexToAddress(*token)
+
+		destination, err := cosmtypes.AccAddressFromBech32(*to)
+		if err != nil {
+			log.WithError(err).Fatalln("invalid Injective destination address")
+		}
+
+		depositAmount, err := decimal.NewFromString(*amount)
+		if err != nil || !depositAmount.IsPositive() {
+			log.WithField("amount", *amount).Fatalln("deposit amount must be a positive number")
+		}
+
+		ctx, cancelFn := context.WithTimeout(context.Background(), duration(*timeout, 10*time.Minute))
+		defer cancelFn()
+
+		peggyQuery, ethNetwork, err := txOpts.connect(ctx)
+		if err != nil {
+			log.WithError(err).Fatalln("failed to connect")
+		}
+
+		ethKeyFromAddress := ethNetwork.FromAddress()
+
+		decimals, err := ethNetwork.TokenDecimals(ctx, tokenAddr)
+		if err != nil {
+			log.WithError(err).Fatalln("failed to get token decimals")
+		}
+
+		rawAmount := depositAmount.Shift(int32(decimals))
+		if !rawAmount.IsInteger() {
+			log.WithField("decimals", decimals).Fatalln("deposit amount has more decimal places than the token")
+		}
+
+		log.WithFields(log.Fields{
+			"token":  tokenAddr.Hex(),
+			"amount": rawAmount.String(),
+			"from":   ethKeyFromAddress.Hex(),
+			"to":     destination.String(),
+		}).Infoln("Depositing to Injective")
+
+		actionConfirmed := *txOpts.alwaysAutoConfirm || stdinConfirm("Confirm SendToCosmos transaction? [y/N]: ")
+		if !actionConfirmed {
+			return
+		}
+
+		txHash, err := ethNetwork.SendToCosmos(ctx, tokenAddr, rawAmount.BigInt(), destination, ethKeyFromAddress)
+		if err != nil {
+			log.WithError(err).Fatalln("failed to send deposit tx")
+		}
+
+		receipt, err := waitForReceipt(ctx, ethNetwork.Provider(), *txHash)
+		if err != nil {
+			log.WithError(err).WithField("tx_hash", txHash.Hex()).Fatalln("deposit tx failed")
+		}
+
+		eventNonce, err := sendToCosmosEventNonce(ethNetwork, receipt)
+		if err != nil {
+			log.WithError(err).WithField("tx_hash", txHash.Hex()).Fatalln("failed to find the deposit event")
+		}
+
+		log.WithFields(log.Fields{
+			"tx_hash":     txHash.Hex(),
+			"block":       receipt.BlockNumber,
+			"event_nonce": eventNonce,
+		}).Infoln("Deposit mined on Ethereum")
+
+		if !*waitObserved {
+			return
+		}
+
+		if err := waitForObservedEvent(ctx, peggyQuery, eventNonce); err != nil {
+			log.WithError(err).WithField("event_nonce", eventNonce).Fatalln("deposit was not observed on Injective")
+		}
+
+		log.WithField("event_nonce", eventNonce).Infoln("Deposit observed on Injective")
+	}
+}
+
+// ethTxOptions are the options of commands that sign and send Ethereum txs to the Peggy contract.
+type ethTxOptions struct {
+	// Cosmos params
+	cosmosGRPC *string
+
+	// Peggy contract, read from the peggy params if not set
+	peggyContract *string
+
+	// Ethereum params
+	ethChainID            *int
+	ethNodeRPC            *string
+	ethGasPriceAdjustment *float64
+	ethMaxGasPrice        *string
+
+	// Ethereum Key Management
+	ethKeystoreDir *string
+	ethKeyFrom     *string
+	ethPassphrase  *string
+	ethPrivKey     *string
+	ethUseLedger   *bool
+
+	ethRemoteSignerURL        *string
+	ethRemoteSignerAPI        *string
+	ethRemoteSignerCACert     *string
+	ethRemoteSignerClientCert *string
+	ethRemoteSignerClientKey  *string
+
+	// Misc
+	alwaysAutoConfirm *bool
+}
+
+func initEthTxOptions(cmd *cli.Cmd) *ethTxOptions {
+	opts := initEthSignerOptions(cmd)
+
+	opts.peggyContract = cmd.String(cli.StringOpt{
+		Name:   "peggy-contract",
+		Desc:   "Address of the Peggy contract, read from the Peggy module params if not set",
+		EnvVar: "PEGGO_PEGGY_CONTRACT",
+	})
+
+	return opts
+}
+
+// initEthSignerOptions sets the options of ethTxOptions, except for the Peggy
+// contract, for commands that don't talk to an existing one.
+func initEthSignerOptions(cmd *cli.Cmd) *ethTxOptions {
+	opts := &ethTxOptions{}
+
+	opts.cosmosGRPC = cmd.String(cli.StringOpt{
+		Name:   "cosmos-grpc",
+		Desc:   "Cosmos GRPC querying endpoint",
+		EnvVar: "PEGGO_COSMOS_GRPC",
+		Value:  "tcp://localhost:9900",
+	})
+
+	initEthereumOptions(
+		cmd,
+		&opts.ethChainID,
+		&opts.ethNodeRPC,
+		&opts.ethGasPriceAdjustment,
+		&opts.ethMaxGasPrice,
+	)
+
+	initEthereumKeyOptions(
+		cmd,
+		&opts.ethKeystoreDir,
+		&opts.ethKeyFrom,
+		&opts.ethPassphrase,
+		&opts.ethPrivKey,
+		&opts.ethUseLedger,
+		&opts.ethRemoteSignerURL,
+		&opts.ethRemoteSignerAPI,
+		&opts.ethRemoteSignerCACert,
+		&opts.ethRemoteSignerClientCert,
+		&opts.ethRemoteSignerClientKey,
+	)
+
+	initInteractiveOptions(
+		cmd,
+		&opts.alwaysAutoConfirm,
+	)
+
+	return opts
+}
+
+// connect connects to Injective and to the Peggy contract on Ethereum with the loaded Ethereum key.
+func (o *ethTxOptions) connect(ctx context.Context) (cosmos.PeggyQueryClient, *ethereum.Network, error) {
+	peggyQuery, err := dialPeggyQueryClient(ctx, *o.cosmosGRPC)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to connect to Injective")
+	}
+
+	peggyContractAddr, err := resolvePeggyContract(ctx, peggyQuery, *o.peggyContract)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ethKeyFromAddress, signerFn, err := o.ethAccount()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ethNetwork, err := o.connectEthereum(peggyContractAddr, ethKeyFromAddress, signerFn)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return peggyQuery, ethNetwork, nil
+}
+
+// resolvePeggyContract returns the given Peggy contract, or the one in the peggy params when it's empty.
+func resolvePeggyContract(ctx context.Context, peggyQuery cosmos.PeggyQueryClient, peggyContract string) (ethcmn.Address, error) {
+	if len(peggyContract) > 0 {
+		if !ethcmn.IsHexAddress(peggyContract) {
+			return ethcmn.Address{}, errors.Errorf("invalid Peggy contract address: %s", peggyContract)
+		}
+
+		return ethcmn.HexToAddress(peggyContract), nil
+	}
+
+	peggyParams, err := peggyQuery.PeggyParams(ctx)
+	if err != nil {
+		return ethcmn.Address{}, errors.Wrap(err, "failed to query peggy params")
+	}
+
+	return ethcmn.HexToAddress(peggyParams.BridgeEthereumAddress), nil
+}
+
+// ethAccount loads the Ethereum key.
+func (o *ethTxOptions) ethAccount() (ethcmn.Address, bind.SignerFn, error) {
+	ethKeyFromAddress, signerFn, _, err := initEthereumAccountsManager(
+		uint64(*o.ethChainID),
+		o.ethKeystoreDir,
+		o.ethKeyFrom,
+		o.ethPassphrase,
+		o.ethPrivKey,
+		o.ethUseLedger,
+		o.ethRemoteSignerURL,
+		o.ethRemoteSignerAPI,
+		o.ethRemoteSignerCACert,
+		o.ethRemoteSignerClientCert,
+		o.ethRemoteSignerClientKey,
+	)
+	if err != nil {
+		return ethcmn.Address{}, nil, errors.Wrap(err, "failed to init Ethereum account")
+	}
+
+	return ethKeyFromAddress, signerFn, nil
+}
+
+// connectEthereum connects to the Peggy contract with the Ethereum key.
+func (o *ethTxOptions) connectEthereum(
+	peggyContractAddr ethcmn.Address,
+	ethKeyFromAddress ethcmn.Address,
+	signerFn bind.SignerFn,
+) (*ethereum.Network, error) {
+	ethNetwork, err := ethereum.NewNetwork(
+		*o.ethNodeRPC,
+		peggyContractAddr,
+		ethKeyFromAddress,
+		signerFn,
+		*o.ethGasPriceAdjustment,
+		*o.ethMaxGasPrice,
+		"20m",
+		"",
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to connect to Ethereum")
+	}
+
+	return ethNetwork, nil
+}
+
+// cosmosTxOptions are the options of commands that sign and broadcast Injective txs.
+type cosmosTxOptions struct {
+	// Cosmos params
+	cosmosChainID   *string
+	cosmosGRPC      *string
+	tendermintRPC   *string
+	cosmosGasPrices *string
+
+	// Cosmos Key Management
+	cosmosKeyringDir     *string
+	cosmosKeyringAppName *string
+	cosmosKeyringBackend *string
+
+	cosmosKeyFrom       *string
+	cosmosKeyPassphrase *string
+	cosmosPrivKey       *string
+	cosmosUseLedger     *bool
+
+	// Misc
+	alwaysAutoConfirm *bool
+}
+
+func initCosmosTxOptions(cmd *cli.Cmd) *cosmosTxOptions {
+	opts := &cosmosTxOptions{}
+
+	initCosmosOptions(
+		cmd,
+		&opts.cosmosChainID,
+		&opts.cosmosGRPC,
+		&opts.tendermintRPC,
+		&opts.cosmosGasPrices,
+	)
+
+	initCosmosKeyOptions(
+		cmd,
+		&opts.cosmosKeyringDir,
+		&opts.cosmosKeyringAppName,
+		&opts.cosmosKeyringBackend,
+		&opts.cosmosKeyFrom,
+		&opts.cosmosKeyPassphrase,
+		&opts.cosmosPrivKey,
+		&opts.cosmosUseLedger,
+	)
+
+	initInteractiveOptions(
+		cmd,
+		&opts.alwaysAutoConfirm,
+	)
+
+	return opts
+}
+
+// connect loads the Injective key and connects to Injective with it.
+func (o *cosmosTxOptions) connect() (*cosmos.Network, error) {
+	accAddress, cosmosKeyring, err := initCosmosKeyring(
+		o.cosmosKeyringDir,
+		o.cosmosKeyringAppName,
+		o.cosmosKeyringBackend,
+		o.cosmosKeyFrom,
+		o.cosmosKeyPassphrase,
+		o.cosmosPrivKey,
+		o.cosmosUseLedger,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to init Cosmos keyring")
+	}
+
+	// no Ethereum signers are needed, since the commands don't sign any claims or confirms
+	return cosmos.NewNetwork(
+		*o.cosmosChainID,
+		accAddress.String(),
+		*o.cosmosGRPC,
+		*o.cosmosGasPrices,
+		*o.tendermintRPC,
+		cosmosKeyring,
+		nil,
+		nil,
+	)
+}
+
+func sendToEthCmd(cmd *cli.Cmd) {
+	txOpts := initCosmosTxOptions(cmd)
+
+	amount := cmd.String(cli.StringOpt{
+		Name: "amount",
+		Desc: "Amount to withdraw in base units, e.g. 1000000000000000000inj",
+	})
+
+	bridgeFee := cmd.String(cli.StringOpt{
+		Name: "bridge-fee",
+		Desc: "Fee paid to the relayer of the batch, in the same denom as the amount",
+	})
+
+	to := cmd.String(cli.StringOpt{
+		Name: "to",
+		Desc: "Ethereum address receiving the withdrawal",
+	})
+
+	track := cmd.Bool(cli.BoolOpt{
+		Name:  "track",
+		Desc:  "Wait until the withdrawal is batched and the batch is executed on Ethereum",
+		Value: false,
+	})
+
+	timeout := cmd.String(cli.StringOpt{
+		Name:  "timeout",
+		Desc:  "How long to wait for the withdrawal to be queued, or executed with --track",
+		Value: "24h",
+	})
+
+	cmd.Action = func() {
+		// ensure a clean exit
+		defer closer.Close()
+
+		if !ethcmn.IsHexAddress(*to) {
+			log.WithField("to", *to).Fatalln("invalid Ethereum destination address")
+		}
+
+		destination := ethcmn.HexToAddress(*to)
+
+		amountCoin, err := cosmtypes.ParseCoinNormalized(*amount)
+		if err != nil || !amountCoin.IsPositive() {
+			log.WithField("amount", *amount).Fatalln("withdrawal amount must be a positive coin, e.g. 1000000000000000000inj")
+		}
+
+		feeCoin, err := cosmtypes.ParseCoinNormalized(*bridgeFee)
+		if err != nil {
+			log.WithError(err).Fatalln("invalid bridge fee")
+		} else if feeCoin.Denom != amountCoin.Denom {
+			log.WithFields(log.Fields{
+				"amount_denom": amountCoin.Denom,
+				"fee_denom":    feeCoin.Denom,
+			}).Fatalln("bridge fee must be paid in the denom of the withdrawal")
+		}
+
+		injNetwork, err := txOpts.connect()
+		if err != nil {
+			log.WithError(err).Fatalln("failed to connect to Injective")
+		}
+
+		ctx, cancelFn := context.WithTimeout(context.Background(), duration(*timeout, 24*time.Hour))
+		defer cancelFn()
+
+		tokenAddr, err := tokenContractForDenom(ctx, injNetwork, amountCoin.Denom)
+		if err != nil {
+			log.WithError(err).Fatalln("failed to find the ERC20 token of the denom")
+		}
+
+		queuedFees, _, err := queuedWithdrawalFees(ctx, injNetwork, tokenAddr)
+		if err != nil {
+			log.WithError(err).Warningln("failed to get queued withdrawals")
+		}
+
+		msg := &types.MsgSendToEth{
+			Sender:    injNetwork.AccFromAddress().String(),
+			EthDest:   destination.Hex(),
+			Amount:    amountCoin,
+			BridgeFee: feeCoin,
+		}
+
+		gas, txFee, err := simulateCosmosTx(ctx, injNetwork, msg, *txOpts.cosmosGasPrices)
+		if err != nil {
+			log.WithError(err).Fatalln("SendToEth transaction would fail")
+		}
+
+		log.WithFields(log.Fields{
+			"from":        injNetwork.AccFromAddress().String(),
+			"to":          destination.Hex(),
+			"amount":      amountCoin.String(),
+			"bridge_fee":  feeCoin.String(),
+			"token":       tokenAddr.Hex(),
+			"queued_fees": queuedFees.String(),
+			"gas":         gas,
+			"tx_fee":      txFee,
+		}).Infoln("Withdrawing to Ethereum")
+
+		log.Infoln("The withdrawal is relayed once the fees queued for the token are worth a batch, a higher bridge fee gets it relayed sooner")
+
+		actionConfirmed := *txOpts.alwaysAutoConfirm || stdinConfirm("Confirm SendToEth transaction? [y/N]: ")
+		if !actionConfirmed {
+			return
+		}
+
+		sender := injNetwork.AccFromAddress()
+
+		knownIDs, err := pendingTransferIDs(ctx, injNetwork, sender)
+		if err != nil {
+			log.WithError(err).Fatalln("failed to get pending withdrawals")
+		}
+
+		txResponse, err := injNetwork.BroadcastMsgSync(ctx, msg)
+		if err != nil {
+			log.WithError(err).Fatalln("failed to broadcast Tx")
+		}
+
+		log.WithField("tx_hash", txResponse.TxHash).Infoln("SendToEth transaction accepted")
+
+		// the tx passed CheckTx, the withdrawal shows up in the pool once it's committed
+		transferID, err := waitForNewTransfer(ctx, injNetwork, sender, knownIDs)
+		if err != nil {
+			log.WithError(err).Fatalln("withdrawal was not queued")
+		}
+
+		log.WithField("id", transferID).Infoln("Withdrawal queued on Injective")
+
+		if !*track {
+			return
+		}
+
+		if err := trackWithdrawal(ctx, injNetwork, sender, transferID); err != nil {
+			log.WithError(err).WithField("id", transferID).Fatalln("failed to track the withdrawal")
+		}
+
+		log.WithField("id", transferID).Infoln("Withdrawal executed on Ethereum")
+	}
+}
+
+func requestBatchCmd(cmd *cli.Cmd) {
+	txOpts := initCosmosTxOptions(cmd)
+
+	cmd.Spec = "[OPTIONS] DENOM"
+	denom := cmd.StringArg("DENOM", "", "Denom of the queued withdrawals, e.g. inj or peggy0x...")
+
+	cmd.Action = func() {
+		// ensure a clean exit
+		defer closer.Close()
+
+		injNetwork, err := txOpts.connect()
+		if err != nil {
+			log.WithError(err).Fatalln("failed to connect to Injective")
+		}
+
+		ctx, cancelFn := context.WithTimeout(context.Background(), 5*time.Minute)
+		defer cancelFn()
+
+		tokenAddr, err := tokenContractForDenom(ctx, injNetwork, *denom)
+		if err != nil {
+			log.WithError(err).Fatalln("failed to find the ERC20 token of the denom")
+		}
+
+		queuedFees, queued, err := queuedWithdrawalFees(ctx, injNetwork, tokenAddr)
+		if err != nil {
+			log.WithError(err).Fatalln("failed to get queued withdrawals")
+		} else if !queued {
+			log.WithField("denom", *denom).Fatalln("there are no queued withdrawals to batch")
+		}
+
+		msg := &types.MsgRequestBatch{
+			Denom:        *denom,
+			Orchestrator: injNetwork.AccFromAddress().String(),
+		}
+
+		gas, txFee, err := simulateCosmosTx(ctx, injNetwork, msg, *txOpts.cosmosGasPrices)
+		if err != nil {
+			log.WithError(err).Fatalln("RequestBatch transaction would fail")
+		}
+
+		log.WithFields(log.Fields{
+			"denom":       *denom,
+			"token":       tokenAddr.Hex(),
+			"queued_fees": queuedFees.String(),
+			"gas":         gas,
+			"tx_fee":      txFee,
+		}).Infoln("Requesting a batch")
+
+		actionConfirmed := *txOpts.alwaysAutoConfirm || stdinConfirm("Confirm RequestBatch transaction? [y/N]: ")
+		if !actionConfirmed {
+			return
+		}
+
+		lastNonce := latestBatchNonce(ctx, injNetwork, tokenAddr)
+
+		txResponse, err := injNetwork.BroadcastMsgSync(ctx, msg)
+		if err != nil {
+			log.WithError(err).Fatalln("failed to broadcast Tx")
+		}
+
+		log.WithField("tx_hash", txResponse.TxHash).Infoln("RequestBatch transaction accepted")
+
+		// the tx passed CheckTx, the batch shows up once it's committed
+		batch, err := waitForNewBatch(ctx, injNetwork, tokenAddr, lastNonce)
+		if err != nil {
+			log.WithError(err).Fatalln("batch was not created")
+		}
+
+		log.WithFields(log.Fields{
+			"nonce":   batch.BatchNonce,
+			"txs":     len(batch.Transactions),
+			"timeout": batch.BatchTimeout,
+		}).Infoln("Batch created")
+	}
+}
+
+func relayBatchCmd(cmd *cli.Cmd) {
+	txOpts := initEthTxOptions(cmd)
+
+	token := cmd.String(cli.StringOpt{
+		Name: "token",
+		Desc: "ERC20 contract address of the batch token",
+	})
+
+	nonce := cmd.Int(cli.IntOpt{
+		Name: "nonce",
+		Desc: "Nonce of the batch to relay",
+	})
+
+	dryRun := initDryRunOption(cmd)
+
+	cmd.Action = func() {
+		// ensure a clean exit
+		defer closer.Close()
+
+		if !ethcmn.IsHexAddress(*token) {
+			log.WithField("token", *token).Fatalln("invalid token contract address")
+		}
+
+		tokenAddr := ethcmn.HexToAddress(*token)
+		batchNonce := uint64(*nonce)
+
+		ctx, cancelFn := context.WithTimeout(context.Background(), 10*time.Minute)
+		defer cancelFn()
+
+		peggyQuery, ethNetwork, err := txOpts.connect(ctx)
+		if err != nil {
+			log.WithError(err).Fatalln("failed to connect")
+		}
+
+		batch, err := findTransactionBatch(ctx, peggyQuery, tokenAddr, batchNonce)
+		if err != nil {
+			log.WithError(err).Fatalln("failed to find the batch")
+		}
+
+		ethBatchNonce, err := ethNetwork.GetTxBatchNonce(ctx, tokenAddr)
+		if err != nil {
+			log.WithError(err).Fatalln("failed to get the last batch nonce on Ethereum")
+		} else if ethBatchNonce.Uint64() >= batchNonce {
+			log.WithField("eth_batch_nonce", ethBatchNonce.Uint64()).Fatalln("batch was already relayed or superseded on Ethereum")
+		}
+
+		confirms, err := peggyQuery.TransactionBatchSignatures(ctx, batchNonce, tokenAddr)
+		if err != nil {
+			log.WithError(err).Fatalln("failed to get batch confirmations")
+		}
+
+		currentValset, err := orchestrator.FindLatestValsetOnEth(ctx, peggyQuery, ethNetwork)
+		if err != nil {
+			log.WithError(err).Fatalln("failed to find the current valset on Ethereum")
+		}
+
+		signers := make([]string, 0, len(confirms))
+		for _, confirm := range confirms {
+			signers = append(signers, confirm.EthSigner)
+		}
+
+		txData, err := ethNetwork.EncodeTransactionBatch(currentValset, batch, confirms)
+		if err != nil {
+			log.WithError(err).Fatalln("failed to encode the batch")
+		}
+
+		gasPrice, err := ethNetwork.BatchGasPrice(ctx)
+		if err != nil {
+			log.WithError(err).Fatalln("failed to get gas price")
+		}
+
+		summary := log.Fields{
+			"token":         tokenAddr.Hex(),
+			"nonce":         batchNonce,
+			"txs":           len(batch.Transactions),
+			"fees":          batchTotalFee(batch),
+			"valset_nonce":  currentValset.Nonce,
+			"confirms":      len(confirms),
+			"signing_power": fmt.Sprintf("%.2f%%", peggy.SignedPowerPercent(currentValset, signers)),
+			"gas_price":     gasPrice.String(),
+		}
+
+		if !confirmPeggyCall(ctx, ethNetwork.Provider(), ethNetwork.Address(), ethNetwork.FromAddress(), "SubmitBatch", txData, summary, *dryRun, *txOpts.alwaysAutoConfirm) {
+			return
+		}
+
+		txHash, err := ethNetwork.SendTransactionBatch(ctx, currentValset, batch, confirms)
+		if err != nil {
+			log.WithError(err).Fatalln("failed to send batch tx")
+		}
+
+		receipt, err := waitForReceipt(ctx, ethNetwork.Provider(), *txHash)
+		if err != nil {
+			log.WithError(err).WithField("tx_hash", txHash.Hex()).Fatalln("batch tx failed")
+		}
+
+		log.WithFields(log.Fields{
+			"tx_hash":  txHash.Hex(),
+			"block":    receipt.BlockNumber,
+			"gas_used": receipt.GasUsed,
+		}).Infoln("Batch relayed to Ethereum")
+	}
+}
+
+func relayValsetCmd(cmd *cli.Cmd) {
+	txOpts := initEthTxOptions(cmd)
+
+	nonce := cmd.Int(cli.IntOpt{
+		Name: "nonce",
+		Desc: "Nonce of the valset to relay",
+	})
+
+	dryRun := initDryRunOption(cmd)
+
+	cmd.Action = func() {
+		// ensure a clean exit
+		defer closer.Close()
+
+		valsetNonce := uint64(*nonce)
+
+		ctx, cancelFn := context.WithTimeout(context.Background(), 10*time.Minute)
+		defer cancelFn()
+
+		peggyQuery, ethNetwork, err := txOpts.connect(ctx)
+		if err != nil {
+			log.WithError(err).Fatalln("failed to connect")
+		}
+
+		newValset, err := peggyQuery.ValsetAt(ctx, valsetNonce)
+		if err != nil {
+			log.WithError(err).Fatalln("failed to get the valset")
+		} else if newValset == nil {
+			log.WithField("nonce", valsetNonce).Fatalln("no valset with this nonce on Injective")
+		}
+
+		currentValset, err := orchestrator.FindLatestValsetOnEth(ctx, peggyQuery, ethNetwork)
+		if err != nil {
+			log.WithError(err).Fatalln("failed to find the current valset on Ethereum")
+		} else if currentValset.Nonce >= valsetNonce {
+			log.WithField("eth_valset_nonce", currentValset.Nonce).Fatalln("valset was already relayed or superseded on Ethereum")
+		}
+
+		confirms, err := peggyQuery.AllValsetConfirms(ctx, valsetNonce)
+		if err != nil {
+			log.WithError(err).Fatalln("failed to get valset confirmations")
+		}
+
+		signers := make([]string, 0, len(confirms))
+		for _, confirm := range confirms {
+			signers = append(signers, confirm.EthAddress)
+		}
+
+		txData, err := ethNetwork.EncodeValsetUpdate(currentValset, newValset, confirms)
+		if err != nil {
+			log.WithError(err).Fatalln("failed to encode the valset update")
+		}
+
+		gasPrice, err := ethNetwork.ValsetGasPrice(ctx)
+		if err != nil {
+			log.WithError(err).Fatalln("failed to get gas price")
+		}
+
+		summary := log.Fields{
+			"nonce":            valsetNonce,
+			"members":          len(newValset.Members),
+			"eth_valset_nonce": currentValset.Nonce,
+			"confirms":         len(confirms),
+			"signing_power":    fmt.Sprintf("%.2f%%", peggy.SignedPowerPercent(currentValset, signers)),
+			"gas_price":        gasPrice.String(),
+		}
+
+		if !confirmPeggyCall(ctx, ethNetwork.Provider(), ethNetwork.Address(), ethNetwork.FromAddress(), "UpdateValset", txData, summary, *dryRun, *txOpts.alwaysAutoConfirm) {
+			return
+		}
+
+		txHash, err := ethNetwork.SendEthValsetUpdate(ctx, currentValset, newValset, confirms)
+		if err != nil {
+			log.WithError(err).Fatalln("failed to send valset update tx")
+		}
+
+		receipt, err := waitForReceipt(ctx, ethNetwork.Provider(), *txHash)
+		if err != nil {
+			log.WithError(err).WithField("tx_hash", txHash.Hex()).Fatalln("valset update tx failed")
+		}
+
+		log.WithFields(log.Fields{
+			"tx_hash":  txHash.Hex(),
+			"block":    receipt.BlockNumber,
+			"gas_used": receipt.GasUsed,
+		}).Infoln("Valset relayed to Ethereum")
+	}
+}
+
+// findTransactionBatch returns the outgoing batch of the token with the given nonce.
+func findTransactionBatch(
+	ctx context.Context,
+	peggyQuery cosmos.PeggyQueryClient,
+	tokenAddr ethcmn.Address,
+	batchNonce uint64,
+) (*types.OutgoingTxBatch, error) {
+	batches, err := peggyQuery.LatestTransactionBatches(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get outgoing batches")
+	}
+
+	for _, batch := range batches {
+		if batch.BatchNonce == batchNonce && ethcmn.HexToAddress(batch.TokenContract) == tokenAddr {
+			return batch, nil
+		}
+	}
+
+	return nil, errors.Errorf("no outgoing batch of token %s with nonce %d", tokenAddr.Hex(), batchNonce)
+}
+
+// confirmPeggyCall estimates the gas of the Peggy contract call, which also simulates it
+// against the latest Ethereum state, logs the summary and asks for confirmation.
+// It returns whether the tx should be sent.
+func confirmPeggyCall(
+	ctx context.Context,
+	estimator ethgo.GasEstimator,
+	peggyContractAddr ethcmn.Address,
+	from ethcmn.Address,
+	method string,
+	txData []byte,
+	summary log.Fields,
+	dryRun bool,
+	autoConfirm bool,
+) bool {
+	summary["from"] = from.Hex()
+	summary["peggy_contract"] = peggyContractAddr.Hex()
+
+	gasEstimate, err := estimator.EstimateGas(ctx, ethgo.CallMsg{
+		From: from,
+		To:   &peggyContractAddr,
+		Data: txData,
+	})
+	if err != nil && !dryRun {
+		log.WithError(err).WithFields(summary).Fatalf("%s tx would fail on Ethereum", method)
+	} else if err != nil {
+		// the summary is still shown, e.g. for submitting the calldata from elsewhere
+		log.WithError(err).WithFields(summary).Warningf("%s tx would fail on Ethereum", method)
+	} else {
+		summary["gas_estimate"] = gasEstimate
+	}
+
+	log.WithFields(summary).Infof("%s summary", method)
+
+	if dryRun {
+		return false
+	}
+
+	return autoConfirm || stdinConfirm(fmt.Sprintf("Confirm %s transaction? [y/N]: ", method))
+}
+
+// simulateCosmosTx returns the gas used by the msg and the tx fee it costs at the configured gas prices.
+func simulateCosmosTx(ctx context.Context, injNetwork *cosmos.Network, msg cosmtypes.Msg, gasPrices string) (uint64, string, error) {
+	gas, err := injNetwork.SimulateMsg(ctx, msg)
+	if err != nil {
+		return 0, "", err
+	}
+
+	prices, err := cosmtypes.ParseDecCoins(gasPrices)
+	if err != nil {
+		return 0, "", errors.Wrap(err, "invalid cosmos gas prices")
+	}
+
+	fee, _ := prices.MulDec(cosmtypes.NewDec(int64(gas))).TruncateDecimal()
+
+	return gas, fee.String(), nil
+}
+
+// tokenContractForDenom returns the ERC20 token bridged as the denom.
+func tokenContractForDenom(ctx context.Context, injNetwork *cosmos.Network, denom string) (ethcmn.Address, error) {
+	params, err := injNetwork.PeggyParams(ctx)
+	if err != nil {
+		return ethcmn.Address{}, err
+	}
+
+	if denom == params.CosmosCoinDenom {
+		return ethcmn.HexToAddress(params.CosmosCoinErc20Contract), nil
+	}
+
+	if tokenAddr, err := injNetwork.DenomToERC20(ctx, denom); err == nil {
+		return tokenAddr, nil
+	}
+
+	// Ethereum-originated tokens are named after their contract
+	if addr := strings.TrimPrefix(denom, "peggy"); addr != denom && ethcmn.IsHexAddress(addr) {
+		return ethcmn.HexToAddress(addr), nil
+	}
+
+	return ethcmn.Address{}, errors.Errorf("denom %s is not bridged", denom)
+}
+
+// queuedWithdrawalFees returns the total fees of the unbatched withdrawals of the token
+// and whether any are queued.
+func queuedWithdrawalFees(ctx context.Context, injNetwork *cosmos.Network, tokenAddr ethcmn.Address) (cosmtypes.Int, bool, error) {
+	fees, err := injNetwork.UnbatchedTokenFees(ctx)
+	if err != nil {
+		return cosmtypes.ZeroInt(), false, err
+	}
+
+	for _, fee := range fees {
+		if ethcmn.HexToAddress(fee.Token) == tokenAddr {
+			return fee.TotalFees, true, nil
+		}
+	}
+
+	return cosmtypes.ZeroInt(), false, nil
+}
+
+func pendingTransferIDs(ctx context.Context, injNetwork *cosmos.Network, sender cosmtypes.AccAddress) (map[uint64]bool, error) {
+	pending, err := injNetwork.PendingSendToEth(ctx, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[uint64]bool)
+	for _, transfer := range append(pending.TransfersInBatches, pending.UnbatchedTransfers...) {
+		ids[transfer.Id] = true
+	}
+
+	return ids, nil
+}
+
+// waitForNewTransfer polls the pending withdrawals of the sender until one that isn't known shows up.
+func waitForNewTransfer(ctx context.Context, injNetwork *cosmos.Network, sender cosmtypes.AccAddress, knownIDs map[uint64]bool) (uint64, error) {
+	ticker := time.NewTicker(3 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		ids, err := pendingTransferIDs(ctx, injNetwork, sender)
+		if err != nil {
+			log.WithError(err).Warningln("failed to get pending withdrawals")
+		}
+
+		for id := range ids {
+			if !knownIDs[id] {
+				return id, nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// trackWithdrawal waits until the withdrawal leaves the pool, which happens once
+// the validators observe the execution of its batch on Ethereum.
+func trackWithdrawal(ctx context.Context, injNetwork *cosmos.Network, sender cosmtypes.AccAddress, transferID uint64) error {
+	ticker := time.NewTicker(30 * time.Second)
+	defer ticker.Stop()
+
+	var lastBatchNonce uint64
+	for {
+		pending, err := injNetwork.PendingSendToEth(ctx, sender)
+		if err != nil {
+			log.WithError(err).Warningln("failed to get pending withdrawals")
+		} else if batchNonce, found := withdrawalBatchNonce(ctx, injNetwork, pending, transferID); !found {
+			return nil
+		} else if batchNonce != lastBatchNonce {
+			lastBatchNonce = batchNonce
+
+			if batchNonce == 0 {
+				log.WithField("id", transferID).Infoln("Withdrawal is waiting for a batch")
+			} else {
+				log.WithFields(log.Fields{
+					"id":          transferID,
+					"batch_nonce": batchNonce,
+				}).Infoln("Withdrawal is batched, waiting for the batch to be relayed")
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// withdrawalBatchNonce returns the nonce of the batch containing the withdrawal, 0 if it isn't batched yet,
+// and whether the withdrawal is still pending at all.
+func withdrawalBatchNonce(
+	ctx context.Context,
+	injNetwork *cosmos.Network,
+	pending *types.QueryPendingSendToEthResponse,
+	transferID uint64,
+) (uint64, bool) {
+	for _, transfer := range pending.UnbatchedTransfers {
+		if transfer.Id == transferID {
+			return 0, true
+		}
+	}
+
+	for _, transfer := range pending.TransfersInBatches {
+		if transfer.Id != transferID {
+			continue
+		}
+
+		batches, err := injNetwork.LatestTransactionBatches(ctx)
+		if err != nil {
+			return 0, true
+		}
+
+		for _, batch := range batches {
+			for _, tx := range batch.Transactions {
+				if tx.Id == transferID {
+					return batch.BatchNonce, true
+				}
+			}
+		}
+
+		return 0, true
+	}
+
+	return 0, false
+}
+
+func latestBatchNonce(ctx context.Context, injNetwork *cosmos.Network, tokenAddr ethcmn.Address) uint64 {
+	batches, err := injNetwork.LatestTransactionBatches(ctx)
+	if err != nil {
+		log.WithError(err).Warningln("failed to get outgoing batches")
+		return 0
+	}
+
+	var nonce uint64
+	for _, batch := range batches {
+		if ethcmn.HexToAddress(batch.TokenContract) == tokenAddr && batch.BatchNonce > nonce {
+			nonce = batch.BatchNonce
+		}
+	}
+
+	return nonce
+}
+
+// waitForNewBatch polls the outgoing batches until one of the token with a nonce above lastNonce shows up.
+func waitForNewBatch(ctx context.Context, injNetwork *cosmos.Network, tokenAddr ethcmn.Address, lastNonce uint64) (*types.OutgoingTxBatch, error) {
+	ticker := time.NewTicker(3 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		batches, err := injNetwork.LatestTransactionBatches(ctx)
+		if err != nil {
+			log.WithError(err).Warningln("failed to get outgoing batches")
+		}
+
+		for _, batch := range batches {
+			if ethcmn.HexToAddress(batch.TokenContract) == tokenAddr && batch.BatchNonce > lastNonce {
+				return batch, nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// waitForReceipt polls the Ethereum node until the tx is mined and fails if the tx reverted.
+func waitForReceipt(ctx context.Context, ethProvider provider.EVMProvider, txHash ethcmn.Hash) (*ethtypes.Receipt, error) {
+	ticker := time.NewTicker(3 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		receipt, err := ethProvider.TransactionReceipt(ctx, txHash)
+		if err == nil {
+			if receipt.Status == ethtypes.ReceiptStatusFailed {
+				return receipt, errors.Errorf("tx %s reverted", txHash.Hex())
+			}
+
+			return receipt, nil
+		} else if err != ethgo.NotFound {
+			log.WithError(err).Warningln("failed to get tx receipt")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, errors.Wrapf(ctx.Err(), "tx %s was not mined in time", txHash.Hex())
+		case <-ticker.C:
+		}
+	}
+}
+
+// sendToCosmosEventNonce returns the nonce of the deposit event emitted in the receipt.
+func sendToCosmosEventNonce(ethNetwork *ethereum.Network, receipt *ethtypes.Receipt) (uint64, error) {
+	peggyFilterer, err := wrappers.NewPeggyFilterer(ethNetwork.Address(), ethNetwork.Provider())
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to init Peggy events filterer")
+	}
+
+	for _, eventLog := range receipt.Logs {
+		if eventLog.Address != ethNetwork.Address() {
+			continue
+		}
+
+		if event, err := peggyFilterer.ParseSendToInjectiveEvent(*eventLog); err == nil {
+			return event.EventNonce.Uint64(), nil
+		}
+	}
+
+	return 0, errors.New("no SendToInjective event in tx receipt")
+}
+
+// waitForObservedEvent polls Injective until the event with the given nonce is attested by the validators.
+func waitForObservedEvent(ctx context.Context, peggyQuery cosmos.PeggyQueryClient, eventNonce uint64) error {
+	ticker := time.NewTicker(5 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		observedNonce, err := peggyQuery.LastObservedEventNonce(ctx)
+		if err != nil {
+			log.WithError(err).Warningln("failed to get last observed event nonce")
+		} else if observedNonce >= eventNonce {
+			return nil
+		} else {
+			log.WithFields(log.Fields{
+				"observed_nonce": observedNonce,
+				"event_nonce":    eventNonce,
+			}).Infoln("Waiting for validators to observe the event")
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
 // waitForService awaits an active ClientConn to a GRPC service.
 func waitForService(ctx context.Context, clientconn *grpc.ClientConn) {
 	for {
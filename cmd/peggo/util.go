@@ -4,13 +4,17 @@ import (
 	"bufio"
 	"bytes"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"math/big"
 	"os"
 	"strings"
 	"time"
 
 	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
 	log "github.com/xlab/suplog"
 	"google.golang.org/grpc"
 )
@@ -52,24 +56,59 @@ func stdinConfirm(msg string) bool {
 	}
 }
 
+// stdinConfirmValue asks the user to type the expected value, used to confirm irreversible actions.
+func stdinConfirmValue(msg, expected string) bool {
+	var response string
+
+	fmt.Print(msg)
+
+	if _, err := fmt.Scanln(&response); err != nil {
+		log.WithError(err).Errorln("failed to confirm the action")
+		return false
+	}
+
+	return strings.EqualFold(strings.TrimSpace(response), expected)
+}
+
 // parseERC20ContractMapping converts list of address:denom pairs to a proper typed map.
-func parseERC20ContractMapping(items []string) map[ethcmn.Address]string {
+func parseERC20ContractMapping(items []string) (map[ethcmn.Address]string, error) {
 	res := make(map[ethcmn.Address]string)
 
 	for _, item := range items {
-		// item is a pair address:denom
-		parts := strings.Split(item, ":")
-		addr := ethcmn.HexToAddress(parts[0])
+		// item is a pair address:denom, the denom itself may contain colons
+		parts := strings.SplitN(item, ":", 2)
+		if len(parts) != 2 || !ethcmn.IsHexAddress(parts[0]) || len(parts[1]) == 0 {
+			return nil, errors.Errorf("failed to parse ERC20 mapping %s: expected an address:denom pair", item)
+		}
+
+		res[ethcmn.HexToAddress(parts[0])] = parts[1]
+	}
+
+	return res, nil
+}
 
-		if len(parts) != 2 || len(parts[0]) == 0 || addr == (ethcmn.Address{}) {
-			log.Fatalln("failed to parse ERC20 mapping: check that all inputs contain valid denom:address pairs")
+// loadERC20ContractMapping reads a JSON object of ERC20 addresses to denoms.
+func loadERC20ContractMapping(path string) (map[ethcmn.Address]string, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read ERC20 mapping file")
+	}
+
+	var mapping map[string]string
+	if err := json.Unmarshal(data, &mapping); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse ERC20 mapping file %s", path)
+	}
+
+	res := make(map[ethcmn.Address]string, len(mapping))
+	for addr, denom := range mapping {
+		if !ethcmn.IsHexAddress(addr) || len(denom) == 0 {
+			return nil, errors.Errorf("invalid ERC20 mapping %s: %s", addr, denom)
 		}
 
-		denom := parts[1]
-		res[addr] = denom
+		res[ethcmn.HexToAddress(addr)] = denom
 	}
 
-	return res
+	return res, nil
 }
 
 // logLevel converts vague log level name into typed level.
@@ -116,6 +155,30 @@ func checkStatsdPrefix(s string) string {
 	return s
 }
 
+// splitList parses a comma-separated option value, skipping empty items.
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); len(item) > 0 {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
+
+// parseEthAmount converts a decimal amount of ETH into wei.
+func parseEthAmount(s string) (*big.Int, error) {
+	amount, err := decimal.NewFromString(strings.TrimSpace(s))
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid ETH amount: %s", s)
+	} else if amount.IsNegative() {
+		return nil, errors.Errorf("ETH amount must not be negative: %s", s)
+	}
+
+	return amount.Shift(18).BigInt(), nil
+}
+
 func hexToBytes(str string) ([]byte, error) {
 	if strings.HasPrefix(str, "0x") {
 		str = str[2:]
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+	"io"
+	"os"
+
+	cli "github.com/jawher/mow.cli"
+	"github.com/pkg/errors"
+	log "github.com/xlab/suplog"
+
+	"github.com/InjectiveLabs/peggo/orchestrator"
+	"github.com/InjectiveLabs/peggo/orchestrator/cosmos"
+)
+
+// verifyCmdSubset contains consistency checks between Injective and the Peggy contract.
+//
+// $ peggo verify
+func verifyCmdSubset(cmd *cli.Cmd) {
+	cmd.Command("checkpoint", "Compares the valset checkpoint in the Peggy contract with the Injective valset", verifyCheckpointCmd)
+}
+
+func verifyCheckpointCmd(cmd *cli.Cmd) {
+	var cosmosGRPC, output *string
+	initQueryOptions(cmd, &cosmosGRPC, &output)
+
+	ethNodeRPC := initEthereumRPCOption(cmd)
+
+	peggyContract := cmd.String(cli.StringOpt{
+		Name:   "peggy-contract",
+		Desc:   "Address of the Peggy contract, read from the Peggy module params if not set",
+		EnvVar: "PEGGO_PEGGY_CONTRACT",
+	})
+
+	cmd.Action = func() {
+		var match bool
+
+		runQuery(*cosmosGRPC, func(ctx context.Context, peggyQuery cosmos.PeggyQueryClient) error {
+			peggyAddr, err := resolvePeggyContract(ctx, peggyQuery, *peggyContract)
+			if err != nil {
+				return err
+			}
+
+			peggyReader, err := dialPeggyReader(ctx, *ethNodeRPC, peggyAddr)
+			if err != nil {
+				return err
+			}
+
+			report, err := orchestrator.VerifyValsetCheckpoint(ctx, peggyQuery, peggyReader)
+			if err != nil {
+				return errors.Wrap(err, "failed to verify valset checkpoint")
+			}
+
+			match = report.Match
+
+			return printOutput(*output, report, func(w io.Writer) {
+				printRow(w, "valset_nonce", report.ValsetNonce)
+				printRow(w, "peggy_id", report.PeggyID.Hex())
+				printRow(w, "on_chain", report.OnChain.Hex())
+				printRow(w, "off_chain", report.OffChain.Hex())
+				printRow(w, "match", report.Match)
+			})
+		})
+
+		if !match {
+			log.Errorln("valset checkpoint in the Peggy contract doesn't match the Injective valset")
+			os.Exit(1)
+		}
+	}
+}
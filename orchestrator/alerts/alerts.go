@@ -0,0 +1,234 @@
+// Package alerts notifies operators about critical bridge conditions through
+// pluggable sinks, with deduplication and rate limiting.
+//
+// Alerts are raised with the package-level functions, which do nothing until Init is called.
+package alerts
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/xlab/suplog"
+)
+
+type Severity int
+
+const (
+	SeverityInfo Severity = iota
+	SeverityWarning
+	SeverityCritical
+)
+
+func (s Severity) String() string {
+	switch s {
+	case SeverityInfo:
+		return "info"
+	case SeverityWarning:
+		return "warning"
+	default:
+		return "critical"
+	}
+}
+
+// ParseSeverity parses info, warning or critical.
+func ParseSeverity(s string) (Severity, error) {
+	switch strings.ToLower(s) {
+	case "info":
+		return SeverityInfo, nil
+	case "warning":
+		return SeverityWarning, nil
+	case "critical":
+		return SeverityCritical, nil
+	default:
+		return 0, errors.Errorf("unknown alert severity: %s", s)
+	}
+}
+
+// Alert is a condition that needs an operator.
+type Alert struct {
+	// Key identifies the condition, repeated alerts with the same key are deduplicated.
+	Key      string
+	Severity Severity
+	Summary  string
+	Fields   map[string]interface{}
+	Time     time.Time
+}
+
+// Sink delivers alerts somewhere an operator sees them.
+type Sink interface {
+	Name() string
+	Send(ctx context.Context, alert Alert) error
+}
+
+type Config struct {
+	// MinSeverity drops alerts below it.
+	MinSeverity Severity
+	// DedupWindow is how long an alert with the same key is not repeated.
+	DedupWindow time.Duration
+	// RateLimit is the max number of alerts sent per minute. Critical alerts are never rate limited.
+	RateLimit int
+	// SendTimeout bounds the delivery of an alert to a single sink.
+	SendTimeout time.Duration
+}
+
+const rateWindow = time.Minute
+
+// Alerter sends alerts to the sinks in the background.
+type Alerter struct {
+	cfg   Config
+	sinks []Sink
+	log   log.Logger
+	now   func() time.Time
+
+	mux      sync.Mutex
+	lastSent map[string]time.Time
+	recent   []time.Time
+
+	wg sync.WaitGroup
+}
+
+func NewAlerter(cfg Config, sinks ...Sink) *Alerter {
+	if cfg.SendTimeout == 0 {
+		cfg.SendTimeout = 10 * time.Second
+	}
+
+	return &Alerter{
+		cfg:      cfg,
+		sinks:    sinks,
+		log:      log.WithField("svc", "alerts"),
+		now:      time.Now,
+		lastSent: make(map[string]time.Time),
+	}
+}
+
+// Alert sends the alert to every sink, unless it's below the min severity,
+// a duplicate or over the rate limit. It doesn't wait for the delivery.
+func (a *Alerter) Alert(alert Alert) {
+	if alert.Time.IsZero() {
+		alert.Time = a.now()
+	}
+
+	if !a.admit(alert) {
+		return
+	}
+
+	for _, sink := range a.sinks {
+		a.wg.Add(1)
+
+		go func(sink Sink) {
+			defer a.wg.Done()
+
+			ctx, cancelFn := context.WithTimeout(context.Background(), a.cfg.SendTimeout)
+			defer cancelFn()
+
+			if err := sink.Send(ctx, alert); err != nil {
+				a.log.WithError(err).WithFields(log.Fields{
+					"sink":  sink.Name(),
+					"alert": alert.Key,
+				}).Warningln("failed to send alert")
+			}
+		}(sink)
+	}
+}
+
+func (a *Alerter) admit(alert Alert) bool {
+	if alert.Severity < a.cfg.MinSeverity {
+		return false
+	}
+
+	a.mux.Lock()
+	defer a.mux.Unlock()
+
+	if last, ok := a.lastSent[alert.Key]; ok && alert.Time.Sub(last) < a.cfg.DedupWindow {
+		return false
+	}
+
+	// drop the sends that left the rate window
+	recent := a.recent[:0]
+	for _, t := range a.recent {
+		if alert.Time.Sub(t) < rateWindow {
+			recent = append(recent, t)
+		}
+	}
+	a.recent = recent
+
+	if alert.Severity < SeverityCritical && a.cfg.RateLimit > 0 && len(a.recent) >= a.cfg.RateLimit {
+		a.log.WithField("alert", alert.Key).Warningln("alert rate limit reached, dropping alert")
+		return false
+	}
+
+	a.lastSent[alert.Key] = alert.Time
+	a.recent = append(a.recent, alert.Time)
+
+	return true
+}
+
+// Wait blocks until the alerts being sent are delivered or the timeout passes.
+func (a *Alerter) Wait(timeout time.Duration) {
+	done := make(chan struct{})
+	go func() {
+		a.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(timeout):
+	}
+}
+
+var (
+	defaultMux     sync.RWMutex
+	defaultAlerter *Alerter
+)
+
+// Init sets the alerter used by the package-level functions.
+func Init(alerter *Alerter) {
+	defaultMux.Lock()
+	defer defaultMux.Unlock()
+
+	defaultAlerter = alerter
+}
+
+// Close waits for the alerts being sent, so they're not lost when the process exits.
+func Close() {
+	if alerter := getDefault(); alerter != nil {
+		alerter.Wait(15 * time.Second)
+	}
+}
+
+func getDefault() *Alerter {
+	defaultMux.RLock()
+	defer defaultMux.RUnlock()
+
+	return defaultAlerter
+}
+
+func raise(severity Severity, key, summary string, fields map[string]interface{}) {
+	alerter := getDefault()
+	if alerter == nil {
+		return
+	}
+
+	alerter.Alert(Alert{
+		Key:      key,
+		Severity: severity,
+		Summary:  summary,
+		Fields:   fields,
+	})
+}
+
+func Info(key, summary string, fields map[string]interface{}) {
+	raise(SeverityInfo, key, summary, fields)
+}
+
+func Warning(key, summary string, fields map[string]interface{}) {
+	raise(SeverityWarning, key, summary, fields)
+}
+
+func Critical(key, summary string, fields map[string]interface{}) {
+	raise(SeverityCritical, key, summary, fields)
+}
@@ -0,0 +1,161 @@
+package alerts
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// standIn records the JSON bodies posted to it.
+type standIn struct {
+	*httptest.Server
+
+	mux    sync.Mutex
+	bodies []map[string]interface{}
+}
+
+func newStandIn(t *testing.T, status int) *standIn {
+	s := &standIn{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		s.mux.Lock()
+		s.bodies = append(s.bodies, body)
+		s.mux.Unlock()
+
+		w.WriteHeader(status)
+	}))
+
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+func (s *standIn) received() []map[string]interface{} {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	return append([]map[string]interface{}(nil), s.bodies...)
+}
+
+func TestAlerter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("sends to every sink", func(t *testing.T) {
+		t.Parallel()
+
+		webhook := newStandIn(t, http.StatusOK)
+		slack := newStandIn(t, http.StatusOK)
+		pagerduty := newStandIn(t, http.StatusAccepted)
+
+		alerter := NewAlerter(Config{DedupWindow: time.Hour},
+			&WebhookSink{URL: webhook.URL},
+			&SlackSink{URL: slack.URL},
+			&PagerDutySink{URL: pagerduty.URL, RoutingKey: "routing-key"},
+		)
+
+		alerter.Alert(Alert{
+			Key:      "valset_mismatch",
+			Severity: SeverityCritical,
+			Summary:  "Possible bridge hijacking!",
+			Fields:   map[string]interface{}{"eth_valset_nonce": 7},
+		})
+		alerter.Wait(time.Second)
+
+		require.Len(t, webhook.received(), 1)
+		assert.Equal(t, "valset_mismatch", webhook.received()[0]["key"])
+		assert.Equal(t, "critical", webhook.received()[0]["severity"])
+		assert.Equal(t, map[string]interface{}{"eth_valset_nonce": float64(7)}, webhook.received()[0]["fields"])
+
+		require.Len(t, slack.received(), 1)
+		assert.Contains(t, slack.received()[0]["text"], "*[CRITICAL]* Possible bridge hijacking!")
+
+		require.Len(t, pagerduty.received(), 1)
+		assert.Equal(t, "routing-key", pagerduty.received()[0]["routing_key"])
+		assert.Equal(t, "valset_mismatch", pagerduty.received()[0]["dedup_key"])
+		assert.Equal(t, "critical", pagerduty.received()[0]["payload"].(map[string]interface{})["severity"])
+	})
+
+	t.Run("deduplicates by key", func(t *testing.T) {
+		t.Parallel()
+
+		webhook := newStandIn(t, http.StatusOK)
+		alerter := NewAlerter(Config{DedupWindow: time.Hour}, &WebhookSink{URL: webhook.URL})
+
+		now := time.Now()
+		alerter.now = func() time.Time { return now }
+
+		alerter.Alert(Alert{Key: "gas_price", Severity: SeverityWarning})
+		alerter.Alert(Alert{Key: "gas_price", Severity: SeverityWarning})
+		alerter.Alert(Alert{Key: "claims_failed", Severity: SeverityWarning})
+
+		now = now.Add(2 * time.Hour)
+		alerter.Alert(Alert{Key: "gas_price", Severity: SeverityWarning})
+		alerter.Wait(time.Second)
+
+		assert.Len(t, webhook.received(), 3)
+	})
+
+	t.Run("drops alerts below min severity", func(t *testing.T) {
+		t.Parallel()
+
+		webhook := newStandIn(t, http.StatusOK)
+		alerter := NewAlerter(Config{MinSeverity: SeverityWarning}, &WebhookSink{URL: webhook.URL})
+
+		alerter.Alert(Alert{Key: "info", Severity: SeverityInfo})
+		alerter.Wait(time.Second)
+
+		assert.Empty(t, webhook.received())
+	})
+
+	t.Run("rate limits all but critical alerts", func(t *testing.T) {
+		t.Parallel()
+
+		webhook := newStandIn(t, http.StatusOK)
+		alerter := NewAlerter(Config{RateLimit: 2}, &WebhookSink{URL: webhook.URL})
+
+		alerter.Alert(Alert{Key: "a", Severity: SeverityWarning})
+		alerter.Alert(Alert{Key: "b", Severity: SeverityWarning})
+		alerter.Alert(Alert{Key: "c", Severity: SeverityWarning})
+		alerter.Alert(Alert{Key: "d", Severity: SeverityCritical})
+		alerter.Wait(time.Second)
+
+		keys := make(map[interface{}]bool)
+		for _, body := range webhook.received() {
+			keys[body["key"]] = true
+		}
+
+		assert.Equal(t, map[interface{}]bool{"a": true, "b": true, "d": true}, keys)
+	})
+}
+
+func TestCommandSink(t *testing.T) {
+	t.Parallel()
+
+	out := filepath.Join(t.TempDir(), "alert.json")
+	alerter := NewAlerter(Config{}, &CommandSink{Command: `echo "$PEGGO_ALERT_SEVERITY" > ` + out + `.severity && cat > ` + out})
+
+	alerter.Alert(Alert{Key: "signer_failed", Severity: SeverityCritical, Summary: "signer stopped"})
+	alerter.Wait(5 * time.Second)
+
+	severity, err := ioutil.ReadFile(out + ".severity")
+	require.NoError(t, err)
+	assert.Equal(t, "critical\n", string(severity))
+
+	payload, err := ioutil.ReadFile(out)
+	require.NoError(t, err)
+
+	var body map[string]interface{}
+	require.NoError(t, json.Unmarshal(payload, &body))
+	assert.Equal(t, "signer_failed", body["key"])
+	assert.Equal(t, "signer stopped", body["summary"])
+}
@@ -0,0 +1,186 @@
+package alerts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultPagerDutyURL is the PagerDuty Events API v2 endpoint.
+const DefaultPagerDutyURL = "https://events.pagerduty.com/v2/enqueue"
+
+type webhookPayload struct {
+	Key      string                 `json:"key"`
+	Severity string                 `json:"severity"`
+	Summary  string                 `json:"summary"`
+	Fields   map[string]interface{} `json:"fields,omitempty"`
+	Time     time.Time              `json:"time"`
+	Source   string                 `json:"source"`
+}
+
+func newWebhookPayload(alert Alert) webhookPayload {
+	return webhookPayload{
+		Key:      alert.Key,
+		Severity: alert.Severity.String(),
+		Summary:  alert.Summary,
+		Fields:   alert.Fields,
+		Time:     alert.Time.UTC(),
+		Source:   source(),
+	}
+}
+
+// WebhookSink posts the alert as JSON to a URL.
+type WebhookSink struct {
+	URL    string
+	Client *http.Client
+}
+
+func (s *WebhookSink) Name() string { return "webhook" }
+
+func (s *WebhookSink) Send(ctx context.Context, alert Alert) error {
+	return postJSON(ctx, s.Client, s.URL, newWebhookPayload(alert))
+}
+
+// SlackSink posts the alert to a Slack incoming webhook, or anything accepting its payload.
+type SlackSink struct {
+	URL    string
+	Client *http.Client
+}
+
+func (s *SlackSink) Name() string { return "slack" }
+
+func (s *SlackSink) Send(ctx context.Context, alert Alert) error {
+	var text strings.Builder
+	fmt.Fprintf(&text, "*[%s]* %s", strings.ToUpper(alert.Severity.String()), alert.Summary)
+	fmt.Fprintf(&text, "\n`%s` on `%s`", alert.Key, source())
+
+	for _, k := range sortedKeys(alert.Fields) {
+		fmt.Fprintf(&text, "\n• %s: `%v`", k, alert.Fields[k])
+	}
+
+	return postJSON(ctx, s.Client, s.URL, map[string]string{
+		"text": text.String(),
+	})
+}
+
+// PagerDutySink triggers a PagerDuty incident through the Events API v2. The alert key
+// is the dedup key, so repeated alerts are grouped into the same incident.
+type PagerDutySink struct {
+	URL        string
+	RoutingKey string
+	Client     *http.Client
+}
+
+func (s *PagerDutySink) Name() string { return "pagerduty" }
+
+func (s *PagerDutySink) Send(ctx context.Context, alert Alert) error {
+	url := s.URL
+	if url == "" {
+		url = DefaultPagerDutyURL
+	}
+
+	return postJSON(ctx, s.Client, url, map[string]interface{}{
+		"routing_key":  s.RoutingKey,
+		"event_action": "trigger",
+		"dedup_key":    alert.Key,
+		"payload": map[string]interface{}{
+			"summary":        alert.Summary,
+			"source":         source(),
+			"severity":       alert.Severity.String(),
+			"timestamp":      alert.Time.UTC().Format(time.RFC3339),
+			"component":      "peggo",
+			"custom_details": alert.Fields,
+		},
+	})
+}
+
+// CommandSink runs a local command for every alert, with the alert JSON on stdin
+// and PEGGO_ALERT_KEY, PEGGO_ALERT_SEVERITY and PEGGO_ALERT_SUMMARY in the environment.
+type CommandSink struct {
+	Command string
+}
+
+func (s *CommandSink) Name() string { return "command" }
+
+func (s *CommandSink) Send(ctx context.Context, alert Alert) error {
+	payload, err := json.Marshal(newWebhookPayload(alert))
+	if err != nil {
+		return err
+	}
+
+	cmd := exec.CommandContext(ctx, "sh", "-c", s.Command)
+	cmd.Stdin = bytes.NewReader(payload)
+	cmd.Env = append(os.Environ(),
+		"PEGGO_ALERT_KEY="+alert.Key,
+		"PEGGO_ALERT_SEVERITY="+alert.Severity.String(),
+		"PEGGO_ALERT_SUMMARY="+alert.Summary,
+	)
+
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return errors.Wrapf(err, "alert command failed: %s", strings.TrimSpace(string(out)))
+	}
+
+	return nil
+}
+
+func postJSON(ctx context.Context, client *http.Client, url string, v interface{}) error {
+	body, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 512))
+		return errors.Errorf("%s responded with %s: %s", url, resp.Status, strings.TrimSpace(string(respBody)))
+	}
+
+	return nil
+}
+
+func source() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return "peggo"
+	}
+
+	return "peggo@" + hostname
+}
+
+func sortedKeys(fields map[string]interface{}) []string {
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
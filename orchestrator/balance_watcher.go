@@ -0,0 +1,281 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	eth "github.com/ethereum/go-ethereum/common"
+	log "github.com/xlab/suplog"
+
+	"github.com/InjectiveLabs/peggo/orchestrator/alerts"
+	"github.com/InjectiveLabs/peggo/orchestrator/loops"
+)
+
+const (
+	// recentRelays is the number of relays the average gas usage is computed from.
+	recentRelays = 20
+
+	// defaultRelayGas is assumed per relay until the gas used by a relay is known.
+	defaultRelayGas uint64 = 500000
+)
+
+// BalanceWatch configures the watcher of the relayer account balance.
+type BalanceWatch struct {
+	// WarnRelays are the numbers of remaining relays at which a warning is raised, e.g. 50 and 10.
+	WarnRelays []uint64
+	// MinBalance is the hard floor in wei, relaying is paused below it until the account is topped up.
+	MinBalance *big.Int
+}
+
+// BalanceWatcherLoop checks how many relays the committer's sender accounts can still pay for,
+// and pauses relaying when they can't pay for any. Signing isn't affected.
+func (s *PeggyOrchestrator) BalanceWatcherLoop(ctx context.Context) error {
+	return loops.RunLoop(
+		ctx,
+		"BalanceWatcher",
+		defaultLoopDur,
+		func() error { return s.balanceWatcher.run(ctx, s.ethereum) },
+	)
+}
+
+type balanceWatcher struct {
+	log        log.Logger
+	warnRelays []uint64 // sorted from the highest
+	minBalance *big.Int
+
+	// paused is 1 while relaying is paused, accessed atomically
+	paused int32
+
+	mux     sync.Mutex
+	pending []eth.Hash // relay txs with unknown gas usage
+	gasUsed []uint64   // gas used by the recent relays
+	warned  uint64     // the lowest threshold warned about since the last top-up
+}
+
+func newBalanceWatcher(cfg *BalanceWatch) *balanceWatcher {
+	warnRelays := append([]uint64(nil), cfg.WarnRelays...)
+	sort.Slice(warnRelays, func(i, j int) bool { return warnRelays[i] > warnRelays[j] })
+
+	minBalance := cfg.MinBalance
+	if minBalance == nil {
+		minBalance = new(big.Int)
+	}
+
+	return &balanceWatcher{
+		log:        log.WithField("loop", "BalanceWatcher"),
+		warnRelays: warnRelays,
+		minBalance: minBalance,
+	}
+}
+
+// Paused tells if relaying is paused because the account can't pay for it.
+func (w *balanceWatcher) Paused() bool {
+	if w == nil {
+		return false
+	}
+
+	return atomic.LoadInt32(&w.paused) == 1
+}
+
+// pause stops relaying until the next check finds the account topped up.
+func (w *balanceWatcher) pause() {
+	if w == nil {
+		return
+	}
+
+	atomic.StoreInt32(&w.paused, 1)
+}
+
+// trackRelay records a relay tx, its gas usage is read from the receipt once it's mined.
+func (w *balanceWatcher) trackRelay(txHash eth.Hash) {
+	if w == nil {
+		return
+	}
+
+	w.mux.Lock()
+	defer w.mux.Unlock()
+
+	w.pending = append(w.pending, txHash)
+	if len(w.pending) > recentRelays {
+		// never mined, e.g. replaced
+		w.pending = w.pending[len(w.pending)-recentRelays:]
+	}
+}
+
+func (w *balanceWatcher) run(ctx context.Context, ethereum EthereumNetwork) error {
+	w.collectGasUsed(ctx, ethereum)
+
+	// relays are sent from any sender account that can pay for them
+	accounts := ethereum.SenderAddresses()
+	balances := make([]*big.Int, len(accounts))
+	for i, account := range accounts {
+		balance, err := ethereum.BalanceAt(ctx, account)
+		if err != nil {
+			// non-fatal, the balances are checked again on the next iteration
+			w.log.WithError(err).WithField("account", account.Hex()).Warningln("unable to get relayer account balance")
+			return nil
+		}
+
+		balances[i] = balance
+	}
+
+	var relayCost *big.Int
+	if gasPrice, err := ethereum.BatchGasPrice(ctx); err != nil {
+		w.log.WithError(err).Warningln("unable to get gas price, remaining relays are unknown")
+	} else {
+		relayCost = new(big.Int).Mul(new(big.Int).SetUint64(w.avgGasUsed()), gasPrice)
+	}
+
+	w.check(accounts, balances, relayCost)
+
+	return nil
+}
+
+// check pauses relaying when no sender account is above the floor or can pay for a relay,
+// the remaining relays are summed over the accounts since each relay is paid by one of them.
+func (w *balanceWatcher) check(accounts []eth.Address, balances []*big.Int, relayCost *big.Int) {
+	var (
+		total      = new(big.Int)
+		maxBalance = new(big.Int)
+		remaining  *big.Int
+		addrs      = make([]string, len(accounts))
+	)
+
+	if relayCost != nil && relayCost.Sign() > 0 {
+		remaining = new(big.Int)
+	}
+
+	for i, balance := range balances {
+		addrs[i] = accounts[i].Hex()
+		total.Add(total, balance)
+
+		if balance.Cmp(maxBalance) > 0 {
+			maxBalance = balance
+		}
+
+		if remaining != nil {
+			remaining.Add(remaining, new(big.Int).Div(balance, relayCost))
+		}
+	}
+
+	fields := log.Fields{
+		"accounts":    addrs,
+		"balance":     total.String(),
+		"max_balance": maxBalance.String(),
+		"min_balance": w.minBalance.String(),
+	}
+
+	if remaining != nil {
+		fields["remaining_relays"] = remaining.String()
+	}
+
+	canRelay := len(balances) > 0 && maxBalance.Cmp(w.minBalance) >= 0 && (remaining == nil || remaining.Sign() > 0)
+
+	switch {
+	case !canRelay && !w.Paused():
+		w.pause()
+		w.log.WithFields(fields).Errorln("relayer account balances are below the floor or can't pay for a relay, relaying paused until topped up")
+		alerts.Critical("relayer_balance_floor", "Relayer account balance is below the floor, relaying is paused", fields)
+	case canRelay && w.Paused():
+		atomic.StoreInt32(&w.paused, 0)
+		w.log.WithFields(fields).Infoln("relayer account topped up, relaying resumed")
+		alerts.Info("relayer_balance_restored", "Relayer account topped up, relaying resumed", fields)
+	}
+
+	if remaining == nil {
+		return
+	}
+
+	w.mux.Lock()
+	defer w.mux.Unlock()
+
+	var threshold uint64
+	for _, t := range w.warnRelays {
+		if remaining.Cmp(new(big.Int).SetUint64(t)) <= 0 {
+			threshold = t
+		}
+	}
+
+	if threshold == 0 {
+		// above all thresholds, warn again after the next drain
+		w.warned = 0
+		return
+	}
+
+	if w.warned != 0 && threshold >= w.warned {
+		return
+	}
+
+	w.warned = threshold
+	w.log.WithFields(fields).Warningf("relayer account balance is low, enough for %s relays", remaining)
+	alerts.Warning(
+		fmt.Sprintf("relayer_balance_low_%d", threshold),
+		fmt.Sprintf("Relayer account balance is low, enough for %s relays", remaining),
+		fields,
+	)
+}
+
+// collectGasUsed reads the gas used by the mined relay txs.
+func (w *balanceWatcher) collectGasUsed(ctx context.Context, ethereum EthereumNetwork) {
+	w.mux.Lock()
+	pending := w.pending
+	w.pending = nil
+	w.mux.Unlock()
+
+	var (
+		stillPending []eth.Hash
+		gasUsed      []uint64
+	)
+
+	for _, txHash := range pending {
+		receipt, err := ethereum.TransactionReceipt(ctx, txHash)
+		if err != nil || receipt == nil {
+			// not mined yet
+			stillPending = append(stillPending, txHash)
+			continue
+		}
+
+		gasUsed = append(gasUsed, receipt.GasUsed)
+	}
+
+	w.mux.Lock()
+	defer w.mux.Unlock()
+
+	w.pending = append(stillPending, w.pending...)
+	w.gasUsed = append(w.gasUsed, gasUsed...)
+	if len(w.gasUsed) > recentRelays {
+		w.gasUsed = w.gasUsed[len(w.gasUsed)-recentRelays:]
+	}
+}
+
+func (w *balanceWatcher) avgGasUsed() uint64 {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+
+	if len(w.gasUsed) == 0 {
+		return defaultRelayGas
+	}
+
+	var total uint64
+	for _, gas := range w.gasUsed {
+		total += gas
+	}
+
+	return total / uint64(len(w.gasUsed))
+}
+
+// isInsufficientFundsErr tells if a relay failed because the account can't pay for it.
+func isInsufficientFundsErr(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	msg := err.Error()
+	return strings.Contains(msg, "insufficient funds") ||
+		strings.Contains(msg, "no sender account has sufficient balance")
+}
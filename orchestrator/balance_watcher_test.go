@@ -0,0 +1,150 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	eth "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xlab/suplog"
+
+	"github.com/InjectiveLabs/sdk-go/chain/peggy/types"
+)
+
+func TestBalanceWatcher(t *testing.T) {
+	t.Parallel()
+
+	relayTx := eth.HexToHash("0x01")
+	gwei := big.NewInt(1000000000)
+
+	balance := new(big.Int).Mul(big.NewInt(100*200000), gwei) // 100 relays of 200k gas at 1 gwei
+	ethereum := mockEthereum{
+		senderAddressesFn: func() []eth.Address {
+			return []eth.Address{eth.HexToAddress("0x9924e52Fe6B833657335C4a71c8347fb2750742b")}
+		},
+		balanceAtFn: func(context.Context, eth.Address) (*big.Int, error) {
+			return balance, nil
+		},
+		batchGasPriceFn: func(context.Context) (*big.Int, error) {
+			return gwei, nil
+		},
+		transactionReceiptFn: func(_ context.Context, txHash eth.Hash) (*ethtypes.Receipt, error) {
+			if txHash != relayTx {
+				return nil, errors.New("not found")
+			}
+
+			return &ethtypes.Receipt{GasUsed: 200000}, nil
+		},
+	}
+
+	watcher := newBalanceWatcher(&BalanceWatch{
+		WarnRelays: []uint64{10, 50},
+		MinBalance: new(big.Int).Mul(big.NewInt(1000000), gwei), // 0.001 ETH
+	})
+
+	t.Run("gas usage of recent relays", func(t *testing.T) {
+		assert.Equal(t, defaultRelayGas, watcher.avgGasUsed())
+
+		watcher.trackRelay(relayTx)
+		watcher.trackRelay(eth.HexToHash("0x02"))
+		watcher.collectGasUsed(context.Background(), ethereum)
+
+		assert.Equal(t, uint64(200000), watcher.avgGasUsed())
+		assert.Equal(t, []eth.Hash{eth.HexToHash("0x02")}, watcher.pending)
+	})
+
+	t.Run("warns at thresholds", func(t *testing.T) {
+		require.NoError(t, watcher.run(context.Background(), ethereum))
+		assert.False(t, watcher.Paused())
+		assert.Zero(t, watcher.warned)
+
+		balance = new(big.Int).Mul(big.NewInt(40*200000), gwei)
+		require.NoError(t, watcher.run(context.Background(), ethereum))
+		assert.Equal(t, uint64(50), watcher.warned)
+
+		balance = new(big.Int).Mul(big.NewInt(5*200000), gwei)
+		require.NoError(t, watcher.run(context.Background(), ethereum))
+		assert.Equal(t, uint64(10), watcher.warned)
+		assert.False(t, watcher.Paused())
+	})
+
+	t.Run("pauses below floor", func(t *testing.T) {
+		balance = new(big.Int).Mul(big.NewInt(500000), gwei)
+		require.NoError(t, watcher.run(context.Background(), ethereum))
+		assert.True(t, watcher.Paused())
+
+		// topped up
+		balance = new(big.Int).Mul(big.NewInt(1000*200000), gwei)
+		require.NoError(t, watcher.run(context.Background(), ethereum))
+		assert.False(t, watcher.Paused())
+		assert.Zero(t, watcher.warned)
+	})
+
+	t.Run("pauses when a relay can't be paid", func(t *testing.T) {
+		watcher := newBalanceWatcher(&BalanceWatch{})
+
+		balance = new(big.Int).Mul(big.NewInt(100000), gwei)
+		require.NoError(t, watcher.run(context.Background(), ethereum))
+		assert.True(t, watcher.Paused())
+	})
+
+	t.Run("sums the relays of every sender account", func(t *testing.T) {
+		balances := map[eth.Address]*big.Int{
+			eth.HexToAddress("0x9924e52Fe6B833657335C4a71c8347fb2750742b"): new(big.Int),
+			eth.HexToAddress("0x8D983cb9388EaC77af0474fA441C4815500Cb7BB"): new(big.Int).Mul(big.NewInt(30*200000), gwei),
+			eth.HexToAddress("0xe28b3B32B6c345A34Ff64674606124Dd5Aceca30"): new(big.Int).Mul(big.NewInt(25*200000), gwei),
+		}
+
+		pool := ethereum
+		pool.senderAddressesFn = func() []eth.Address {
+			accounts := make([]eth.Address, 0, len(balances))
+			for account := range balances {
+				accounts = append(accounts, account)
+			}
+
+			return accounts
+		}
+		pool.balanceAtFn = func(_ context.Context, account eth.Address) (*big.Int, error) {
+			return balances[account], nil
+		}
+
+		watcher := newBalanceWatcher(&BalanceWatch{
+			WarnRelays: []uint64{10, 50},
+			MinBalance: new(big.Int).Mul(big.NewInt(1000000), gwei),
+		})
+		watcher.gasUsed = []uint64{200000}
+
+		// the empty account is below the floor and every account alone is below 50 relays
+		require.NoError(t, watcher.run(context.Background(), pool))
+		assert.False(t, watcher.Paused())
+		assert.Zero(t, watcher.warned)
+	})
+}
+
+func TestRelayerPausedOnInsufficientFunds(t *testing.T) {
+	t.Parallel()
+
+	inj := &mockInjective{
+		latestValsetsFn: func(context.Context) ([]*types.Valset, error) {
+			return nil, errors.New("insufficient funds for gas * price + value")
+		},
+	}
+
+	rel := &relayer{
+		log:            suplog.DefaultLogger,
+		retries:        3,
+		valsetRelaying: true,
+		balance:        newBalanceWatcher(&BalanceWatch{}),
+	}
+
+	assert.NoError(t, rel.run(context.Background(), inj, nil))
+	assert.True(t, rel.balance.Paused())
+
+	// without a watcher the relayer exits as before
+	rel.balance = nil
+	assert.Error(t, rel.run(context.Background(), inj, nil))
+}
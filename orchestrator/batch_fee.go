@@ -0,0 +1,64 @@
+package orchestrator
+
+import (
+	"context"
+	"math/big"
+
+	eth "github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+// DynamicBatchFee derives the min batch fee from the current cost of relaying the batch:
+// the estimated submitBatch gas, times the gas price, times the ETH/USD price, times the multiplier.
+type DynamicBatchFee struct {
+	// BaseGas is the submitBatch gas that doesn't depend on the number of txs (signature checks, storage).
+	BaseGas uint64
+	// GasPerTx is the gas of each ERC20 transfer in the batch.
+	GasPerTx uint64
+	// MaxTxs is the max number of txs Injective puts in a batch.
+	MaxTxs int
+	// Multiplier is applied to the relaying cost, to leave a profit margin.
+	Multiplier float64
+	// WETHAddress is the token used to query the ETH price from the price feed.
+	WETHAddress eth.Address
+}
+
+// EstimateGas returns the gas needed to submit a batch of txCount txs.
+func (d *DynamicBatchFee) EstimateGas(txCount int) uint64 {
+	if txCount < 1 {
+		txCount = 1
+	} else if d.MaxTxs > 0 && txCount > d.MaxTxs {
+		txCount = d.MaxTxs
+	}
+
+	return d.BaseGas + d.GasPerTx*uint64(txCount)
+}
+
+// MinFeeUSD returns the USD value a batch of txCount txs must carry in fees to be worth relaying.
+func (d *DynamicBatchFee) MinFeeUSD(
+	ctx context.Context,
+	ethereum EthereumNetwork,
+	feed PriceFeed,
+	txCount int,
+) (float64, error) {
+	gasPrice, err := ethereum.BatchGasPrice(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get batch gas price")
+	}
+
+	ethPriceInUSD, err := feed.QueryUSDPrice(d.WETHAddress)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get ETH price")
+	}
+
+	return d.minFeeUSD(d.EstimateGas(txCount), gasPrice, ethPriceInUSD), nil
+}
+
+func (d *DynamicBatchFee) minFeeUSD(gas uint64, gasPrice *big.Int, ethPriceInUSD float64) float64 {
+	costInWei := new(big.Int).Mul(new(big.Int).SetUint64(gas), gasPrice)
+	costInUSD := decimal.NewFromBigInt(costInWei, -18).Mul(decimal.NewFromFloat(ethPriceInUSD))
+
+	minFeeUSD, _ := costInUSD.Mul(decimal.NewFromFloat(d.Multiplier)).Float64()
+	return minFeeUSD
+}
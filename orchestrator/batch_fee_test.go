@@ -0,0 +1,65 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	eth "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDynamicBatchFee(t *testing.T) {
+	t.Parallel()
+
+	weth := eth.HexToAddress("0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2")
+	fee := &DynamicBatchFee{
+		BaseGas:     200000,
+		GasPerTx:    50000,
+		MaxTxs:      100,
+		Multiplier:  1.5,
+		WETHAddress: weth,
+	}
+
+	t.Run("gas estimate", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, uint64(250000), fee.EstimateGas(0))
+		assert.Equal(t, uint64(300000), fee.EstimateGas(2))
+		assert.Equal(t, uint64(5200000), fee.EstimateGas(1000))
+	})
+
+	t.Run("min fee", func(t *testing.T) {
+		t.Parallel()
+
+		ethereum := mockEthereum{
+			batchGasPriceFn: func(context.Context) (*big.Int, error) {
+				return big.NewInt(20e9), nil
+			},
+		}
+
+		feed := mockPriceFeed{queryFn: func(addr eth.Address) (float64, error) {
+			assert.Equal(t, weth, addr)
+			return 2000, nil
+		}}
+
+		// 300000 gas * 20 gwei = 0.006 ETH = 12 USD, * 1.5
+		minFee, err := fee.MinFeeUSD(context.Background(), ethereum, feed, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, 18.0, minFee)
+	})
+
+	t.Run("gas price unavailable", func(t *testing.T) {
+		t.Parallel()
+
+		ethereum := mockEthereum{
+			batchGasPriceFn: func(context.Context) (*big.Int, error) {
+				return nil, errors.New("gas price is greater than max gas price")
+			},
+		}
+
+		_, err := fee.MinFeeUSD(context.Background(), ethereum, mockPriceFeed{}, 2)
+		assert.Error(t, err)
+	})
+}
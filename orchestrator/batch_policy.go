@@ -0,0 +1,138 @@
+package orchestrator
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"strings"
+	"time"
+
+	eth "github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+// BatchRule decides when a batch is requested for a token. All thresholds
+// that are set must be met, unless the oldest queued withdrawal is older than MaxAge.
+type BatchRule struct {
+	// MinFeeUSD is the min total fee of queued withdrawals in USD, 0 means no threshold.
+	MinFeeUSD float64
+	// MinFee is the min total fee in raw token units, nil means no threshold.
+	MinFee *big.Int
+	// MinTxCount is the min number of queued withdrawals, 0 means no threshold.
+	MinTxCount int
+	// MaxAge is how long withdrawals may wait before a batch is requested regardless of the fee, 0 means forever.
+	MaxAge time.Duration
+	// Ignore disables batch requests for the token.
+	Ignore bool
+}
+
+// BatchPolicy holds per-token batch rules. Tokens without a rule use the default rule of the batch requester.
+type BatchPolicy struct {
+	rules map[string]BatchRule
+}
+
+type batchPolicyFile struct {
+	Tokens map[string]batchRuleConfig `json:"tokens"`
+}
+
+type batchRuleConfig struct {
+	MinFeeUSD  float64 `json:"min_fee_usd"`
+	MinFee     string  `json:"min_fee"`
+	MinTxCount int     `json:"min_tx_count"`
+	MaxAge     string  `json:"max_age"`
+	Ignore     bool    `json:"ignore"`
+}
+
+// LoadBatchPolicy reads per-token batch rules from a JSON file. Tokens are keyed
+// either by their ERC20 contract address or by their Injective denom:
+//
+//	{
+//	  "tokens": {
+//	    "0xdAC17F958D2ee523a2206206994597C13D831ec7": {"min_fee_usd": 50, "min_tx_count": 5},
+//	    "inj": {"min_fee_usd": 20, "max_age": "12h"},
+//	    "0xe28b3B32B6c345A34Ff64674606124Dd5Aceca30": {"ignore": true}
+//	  }
+//	}
+func LoadBatchPolicy(path string) (*BatchPolicy, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		err = errors.Wrap(err, "failed to read batch policy file")
+		return nil, err
+	}
+
+	var file batchPolicyFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		err = errors.Wrapf(err, "failed to parse batch policy file %s", path)
+		return nil, err
+	}
+
+	policy := &BatchPolicy{
+		rules: make(map[string]BatchRule, len(file.Tokens)),
+	}
+
+	for token, cfg := range file.Tokens {
+		rule, err := cfg.toRule()
+		if err != nil {
+			err = errors.Wrapf(err, "invalid batch rule for token %s", token)
+			return nil, err
+		}
+
+		policy.rules[policyKey(token)] = rule
+	}
+
+	return policy, nil
+}
+
+func (c batchRuleConfig) toRule() (BatchRule, error) {
+	rule := BatchRule{
+		MinFeeUSD:  c.MinFeeUSD,
+		MinTxCount: c.MinTxCount,
+		Ignore:     c.Ignore,
+	}
+
+	if c.MinFeeUSD < 0 {
+		return BatchRule{}, errors.New("min_fee_usd must not be negative")
+	}
+
+	if c.MinTxCount < 0 {
+		return BatchRule{}, errors.New("min_tx_count must not be negative")
+	}
+
+	if len(c.MinFee) > 0 {
+		minFee, ok := new(big.Int).SetString(c.MinFee, 10)
+		if !ok || minFee.Sign() < 0 {
+			return BatchRule{}, errors.Errorf("min_fee must be a non-negative integer amount, got %s", c.MinFee)
+		}
+
+		rule.MinFee = minFee
+	}
+
+	if len(c.MaxAge) > 0 {
+		maxAge, err := time.ParseDuration(c.MaxAge)
+		if err != nil {
+			return BatchRule{}, errors.Wrap(err, "failed to parse max_age")
+		}
+
+		rule.MaxAge = maxAge
+	}
+
+	return rule, nil
+}
+
+// Rule returns the rule configured for the token, looked up by contract address first and then by denom.
+func (p *BatchPolicy) Rule(tokenAddr eth.Address, denom string) (BatchRule, bool) {
+	if p == nil {
+		return BatchRule{}, false
+	}
+
+	if rule, ok := p.rules[policyKey(tokenAddr.Hex())]; ok {
+		return rule, true
+	}
+
+	rule, ok := p.rules[policyKey(denom)]
+	return rule, ok
+}
+
+func policyKey(token string) string {
+	return strings.ToLower(strings.TrimSpace(token))
+}
@@ -0,0 +1,77 @@
+package orchestrator
+
+import (
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	eth "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadBatchPolicy(t *testing.T) {
+	t.Parallel()
+
+	writePolicy := func(t *testing.T, data string) string {
+		path := filepath.Join(t.TempDir(), "batch_policy.json")
+		assert.NoError(t, ioutil.WriteFile(path, []byte(data), 0600))
+		return path
+	}
+
+	t.Run("rules by address and denom", func(t *testing.T) {
+		t.Parallel()
+
+		path := writePolicy(t, `{
+			"tokens": {
+				"0xdAC17F958D2ee523a2206206994597C13D831ec7": {"min_fee_usd": 50, "min_fee": "1000000", "min_tx_count": 5},
+				"inj": {"max_age": "12h"},
+				"0xe28b3B32B6c345A34Ff64674606124Dd5Aceca30": {"ignore": true}
+			}
+		}`)
+
+		policy, err := LoadBatchPolicy(path)
+		assert.NoError(t, err)
+
+		rule, ok := policy.Rule(eth.HexToAddress("0xdac17f958d2ee523a2206206994597c13d831ec7"), "peggy0xdAC17F958D2ee523a2206206994597C13D831ec7")
+		assert.True(t, ok)
+		assert.Equal(t, 50.0, rule.MinFeeUSD)
+		assert.Equal(t, big.NewInt(1000000), rule.MinFee)
+		assert.Equal(t, 5, rule.MinTxCount)
+
+		rule, ok = policy.Rule(eth.HexToAddress("0xe28b3B32B6c345A34Ff64674606124Dd5Aceca30"), "inj")
+		assert.True(t, ok)
+		assert.True(t, rule.Ignore, "address takes precedence over denom")
+
+		rule, ok = policy.Rule(eth.HexToAddress("0x1"), "inj")
+		assert.True(t, ok)
+		assert.Equal(t, 12*time.Hour, rule.MaxAge)
+
+		_, ok = policy.Rule(eth.HexToAddress("0x2"), "peggy0x2")
+		assert.False(t, ok)
+	})
+
+	t.Run("invalid rules", func(t *testing.T) {
+		t.Parallel()
+
+		for _, data := range []string{
+			`{"tokens": {"inj": {"min_fee": "1.5"}}}`,
+			`{"tokens": {"inj": {"max_age": "soon"}}}`,
+			`{"tokens": {"inj": {"min_tx_count": -1}}}`,
+			`{"tokens": {"inj": {"min_fee_usd": -1}}}`,
+			`not json`,
+		} {
+			_, err := LoadBatchPolicy(writePolicy(t, data))
+			assert.Error(t, err, data)
+		}
+	})
+
+	t.Run("nil policy has no rules", func(t *testing.T) {
+		t.Parallel()
+
+		var policy *BatchPolicy
+		_, ok := policy.Rule(eth.HexToAddress("0x1"), "inj")
+		assert.False(t, ok)
+	})
+}
@@ -2,6 +2,8 @@ package orchestrator
 
 import (
 	"context"
+	"time"
+
 	"github.com/avast/retry-go"
 	eth "github.com/ethereum/go-ethereum/common"
 	"github.com/shopspring/decimal"
@@ -12,18 +14,25 @@ import (
 	cosmtypes "github.com/cosmos/cosmos-sdk/types"
 )
 
+// unbatchedPoolRefreshDur is how long a pool snapshot is used at most, even if the queued fees didn't change.
+const unbatchedPoolRefreshDur = 10 * time.Minute
+
 func (s *PeggyOrchestrator) BatchRequesterLoop(ctx context.Context) (err error) {
 	requester := &batchRequester{
 		log:                  log.WithField("loop", "BatchRequester"),
 		retries:              s.maxAttempts,
 		minBatchFee:          s.minBatchFeeUSD,
+		policy:               s.batchPolicy,
+		dynamicFee:           s.dynamicBatchFee,
 		erc20ContractMapping: s.erc20ContractMapping,
+		createdAt:            make(map[uint64]time.Time),
 	}
 
 	return loops.RunLoop(
 		ctx,
+		"BatchRequester",
 		defaultLoopDur,
-		func() error { return requester.run(ctx, s.injective, s.pricefeed) },
+		func() error { return requester.run(ctx, s.injective, s.ethereum, s.pricefeed) },
 	)
 }
 
@@ -31,12 +40,30 @@ type batchRequester struct {
 	log                  log.Logger
 	retries              uint
 	minBatchFee          float64
-	erc20ContractMapping map[eth.Address]string
+	policy               *BatchPolicy
+	dynamicFee           *DynamicBatchFee
+	erc20ContractMapping *TokenMapping
+
+	// pool is the last snapshot of unbatched withdrawals, it's re-read only when the queued fees change
+	pool          []*types.OutgoingTransferTx
+	poolFetchedAt time.Time
+	// createdAt is when the withdrawals were sent, by id, used by max age rules
+	createdAt map[uint64]time.Time
+	// denoms of the tokens missing from the mapping, looked up on Injective
+	denoms map[eth.Address]string
+}
+
+// tokenQueue describes the unbatched withdrawals of a token.
+type tokenQueue struct {
+	txCount int
+	// age of the oldest withdrawal, zero if unknown
+	age time.Duration
 }
 
 func (r *batchRequester) run(
 	ctx context.Context,
 	injective InjectiveNetwork,
+	ethereum EthereumNetwork,
 	feed PriceFeed,
 ) error {
 	r.log.WithField("min_batch_fee", r.minBatchFee).Infoln("scanning Injective for potential batches")
@@ -53,13 +80,178 @@ func (r *batchRequester) run(
 		return nil
 	}
 
+	r.resolveDenoms(ctx, injective, unbatchedFees)
+
+	var queues map[eth.Address]tokenQueue
+	if r.needsQueues(unbatchedFees) {
+		if queues, err = r.getTokenQueues(ctx, injective, unbatchedFees); err != nil {
+			// rules with a min tx count or max age won't be met this time
+			r.log.WithError(err).Warningln("unable to get unbatched transfers from Injective")
+		}
+	}
+
 	for _, tokenFee := range unbatchedFees {
-		r.requestBatchCreation(ctx, injective, feed, tokenFee)
+		r.requestBatchCreation(ctx, injective, ethereum, feed, tokenFee, queues)
 	}
 
 	return nil
 }
 
+func (r *batchRequester) needsQueues(unbatchedFees []*types.BatchFees) bool {
+	if r.dynamicFee != nil {
+		return true
+	}
+
+	for _, tokenFee := range unbatchedFees {
+		tokenAddr := eth.HexToAddress(tokenFee.Token)
+		denom, _ := r.tokenDenom(tokenAddr)
+		if rule, _ := r.tokenRule(tokenAddr, denom); rule.MinTxCount > 0 || rule.MaxAge > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getTokenQueues returns the number and the age of the unbatched withdrawals of each token.
+func (r *batchRequester) getTokenQueues(
+	ctx context.Context,
+	injective InjectiveNetwork,
+	unbatchedFees []*types.BatchFees,
+) (map[eth.Address]tokenQueue, error) {
+	if err := r.refreshPool(ctx, injective, unbatchedFees); err != nil {
+		return nil, err
+	}
+
+	oldest := make(map[eth.Address]uint64)
+	queues := make(map[eth.Address]tokenQueue)
+	for _, tx := range r.pool {
+		if tx.Erc20Token == nil {
+			continue
+		}
+
+		tokenAddr := eth.HexToAddress(tx.Erc20Token.Contract)
+		queue := queues[tokenAddr]
+		queue.txCount++
+		queues[tokenAddr] = queue
+
+		// ids are sequential, the lowest one is the oldest withdrawal
+		if id, ok := oldest[tokenAddr]; !ok || tx.Id < id {
+			oldest[tokenAddr] = tx.Id
+		}
+	}
+
+	for tokenAddr, id := range oldest {
+		denom, _ := r.tokenDenom(tokenAddr)
+		if rule, _ := r.tokenRule(tokenAddr, denom); rule.MaxAge == 0 {
+			continue
+		}
+
+		queue := queues[tokenAddr]
+		queue.age = time.Since(r.transferCreatedAt(ctx, injective, id))
+		queues[tokenAddr] = queue
+	}
+
+	return queues, nil
+}
+
+// refreshPool re-reads the unbatched withdrawals if the queued fees changed since the last snapshot
+// or it's too old. Reading the pool is heavy, so it's avoided while nothing happens.
+func (r *batchRequester) refreshPool(ctx context.Context, injective InjectiveNetwork, unbatchedFees []*types.BatchFees) error {
+	if r.pool != nil && time.Since(r.poolFetchedAt) < unbatchedPoolRefreshDur && poolMatchesFees(r.pool, unbatchedFees) {
+		return nil
+	}
+
+	var transfers []*types.OutgoingTransferTx
+	retryFn := func() (err error) {
+		transfers, err = injective.UnbatchedTransfers(ctx)
+		return err
+	}
+
+	if err := retry.Do(retryFn,
+		retry.Context(ctx),
+		retry.Attempts(r.retries),
+		retry.OnRetry(func(n uint, err error) {
+			log.WithError(err).Errorf("failed to get unbatched transfers, will retry (%d)", n)
+			loops.ReportError("BatchRequester", err)
+		}),
+	); err != nil {
+		return err
+	}
+
+	if transfers == nil {
+		transfers = []*types.OutgoingTransferTx{}
+	}
+
+	r.pool, r.poolFetchedAt = transfers, time.Now()
+
+	// forget the batched withdrawals
+	queued := make(map[uint64]struct{}, len(transfers))
+	for _, tx := range transfers {
+		queued[tx.Id] = struct{}{}
+	}
+
+	for id := range r.createdAt {
+		if _, ok := queued[id]; !ok {
+			delete(r.createdAt, id)
+		}
+	}
+
+	return nil
+}
+
+// poolMatchesFees tells if the pool snapshot still adds up to the queued fees of every token.
+func poolMatchesFees(pool []*types.OutgoingTransferTx, unbatchedFees []*types.BatchFees) bool {
+	totals := make(map[eth.Address]cosmtypes.Int)
+	for _, tx := range pool {
+		if tx.Erc20Fee == nil {
+			continue
+		}
+
+		tokenAddr := eth.HexToAddress(tx.Erc20Fee.Contract)
+		if total, ok := totals[tokenAddr]; ok {
+			totals[tokenAddr] = total.Add(tx.Erc20Fee.Amount)
+		} else {
+			totals[tokenAddr] = tx.Erc20Fee.Amount
+		}
+	}
+
+	if len(totals) != len(unbatchedFees) {
+		return false
+	}
+
+	for _, tokenFee := range unbatchedFees {
+		total, ok := totals[eth.HexToAddress(tokenFee.Token)]
+		if !ok || !total.Equal(tokenFee.TotalFees) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// transferCreatedAt returns when the withdrawal was sent. If the node can't tell
+// (e.g. tx indexing is off), the time it was first seen is used instead.
+func (r *batchRequester) transferCreatedAt(ctx context.Context, injective InjectiveNetwork, id uint64) time.Time {
+	if r.createdAt == nil {
+		r.createdAt = make(map[uint64]time.Time)
+	}
+
+	if createdAt, ok := r.createdAt[id]; ok {
+		return createdAt
+	}
+
+	createdAt, err := injective.TransferCreatedAt(ctx, id)
+	if err != nil {
+		r.log.WithError(err).WithField("id", id).Debugln("unable to find when the withdrawal was sent, aging it from now")
+		createdAt = time.Now()
+	}
+
+	r.createdAt[id] = createdAt
+
+	return createdAt
+}
+
 func (r *batchRequester) getUnbatchedFeesByToken(ctx context.Context, injective InjectiveNetwork) ([]*types.BatchFees, error) {
 	var unbatchedFees []*types.BatchFees
 	retryFn := func() (err error) {
@@ -72,6 +264,7 @@ func (r *batchRequester) getUnbatchedFeesByToken(ctx context.Context, injective
 		retry.Attempts(r.retries),
 		retry.OnRetry(func(n uint, err error) {
 			log.WithError(err).Errorf("failed to get unbatched fees, will retry (%d)", n)
+			loops.ReportError("BatchRequester", err)
 		}),
 	); err != nil {
 		return nil, err
@@ -83,46 +276,170 @@ func (r *batchRequester) getUnbatchedFeesByToken(ctx context.Context, injective
 func (r *batchRequester) requestBatchCreation(
 	ctx context.Context,
 	injective InjectiveNetwork,
+	ethereum EthereumNetwork,
 	feed PriceFeed,
 	batchFee *types.BatchFees,
+	queues map[eth.Address]tokenQueue,
 ) {
 	var (
-		tokenAddr = eth.HexToAddress(batchFee.Token)
-		denom     = r.tokenDenom(tokenAddr)
+		tokenAddr        = eth.HexToAddress(batchFee.Token)
+		denom, resolved  = r.tokenDenom(tokenAddr)
+		rule, ruleSource = r.tokenRule(tokenAddr, denom)
 	)
 
-	if thresholdMet := r.checkFeeThreshold(feed, tokenAddr, batchFee.TotalFees); !thresholdMet {
+	request, reason := r.decide(ctx, ethereum, feed, tokenAddr, batchFee.TotalFees, rule, queues)
+
+	logger := r.log.WithFields(log.Fields{
+		"denom":          denom,
+		"token_contract": tokenAddr.String(),
+		"total_fees":     batchFee.TotalFees.String(),
+		"rule":           ruleSource,
+		"reason":         reason,
+	})
+
+	if !request {
+		logger.Debugln("skipping batch request")
+		return
+	}
+
+	if !resolved {
+		// a Cosmos-originated token would be requested under the wrong denom
+		logger.Warningln("skipping batch request, the denom of the token is unknown")
+		return
+	}
+
+	logger.Infoln("requesting batch creation on Injective")
+
+	if err := injective.SendRequestBatch(ctx, denom); err == nil {
+		// the withdrawals leave the pool
+		r.pool = nil
+	}
+}
+
+// tokenRule returns the policy rule of the token, or the default rule built from the min batch fee.
+func (r *batchRequester) tokenRule(tokenAddr eth.Address, denom string) (BatchRule, string) {
+	if rule, ok := r.policy.Rule(tokenAddr, denom); ok {
+		return rule, "token"
+	}
+
+	return BatchRule{MinFeeUSD: r.minBatchFee}, "default"
+}
+
+// decide applies the rule to the queued withdrawals of a token, returning
+// whether a batch should be requested and the name of the deciding rule.
+func (r *batchRequester) decide(
+	ctx context.Context,
+	ethereum EthereumNetwork,
+	feed PriceFeed,
+	tokenAddr eth.Address,
+	totalFees cosmtypes.Int,
+	rule BatchRule,
+	queues map[eth.Address]tokenQueue,
+) (bool, string) {
+	if rule.Ignore {
+		return false, "ignore"
+	}
+
+	queue := queues[tokenAddr]
+	if rule.MaxAge > 0 && queue.age >= rule.MaxAge {
+		return true, "max_age"
+	}
+
+	if rule.MinTxCount > 0 && queue.txCount < rule.MinTxCount {
+		return false, "min_tx_count"
+	}
+
+	if rule.MinFee != nil && totalFees.BigInt().Cmp(rule.MinFee) < 0 {
+		return false, "min_fee"
+	}
+
+	minFeeUSD, feeRule := rule.MinFeeUSD, "min_fee_usd"
+	if r.dynamicFee != nil {
+		if queues == nil {
+			return false, "dynamic_fee"
+		}
+
+		dynamicFeeUSD, err := r.dynamicFee.MinFeeUSD(ctx, ethereum, feed, queue.txCount)
+		if err != nil {
+			r.log.WithError(err).WithField("token_contract", tokenAddr.String()).Warningln("unable to compute dynamic batch fee")
+			return false, "dynamic_fee"
+		}
+
 		r.log.WithFields(log.Fields{
-			"denom":          denom,
 			"token_contract": tokenAddr.String(),
-			"total_fees":     batchFee.TotalFees.String(),
-		}).Debugln("skipping underpriced batch")
-		return
+			"tx_count":       queue.txCount,
+			"min_fee_usd":    dynamicFeeUSD,
+		}).Debugln("computed dynamic batch fee")
+
+		// the static threshold stays as a floor
+		if dynamicFeeUSD > minFeeUSD {
+			minFeeUSD, feeRule = dynamicFeeUSD, "dynamic_fee"
+		}
 	}
 
-	r.log.WithFields(log.Fields{
-		"denom":          denom,
-		"token_contract": tokenAddr.String(),
-	}).Infoln("requesting batch creation on Injective")
+	if minFeeUSD > 0 {
+		decimals, err := ethereum.TokenDecimals(ctx, tokenAddr)
+		if err != nil {
+			r.log.WithError(err).WithField("token_contract", tokenAddr.String()).Warningln("unable to get token decimals")
+			return false, feeRule
+		}
 
-	_ = injective.SendRequestBatch(ctx, denom)
+		if !r.checkFeeThreshold(feed, tokenAddr, totalFees, decimals, minFeeUSD) {
+			return false, feeRule
+		}
+	}
+
+	return true, "thresholds_met"
 }
 
-func (r *batchRequester) tokenDenom(tokenAddr eth.Address) string {
-	if cosmosDenom, ok := r.erc20ContractMapping[tokenAddr]; ok {
-		return cosmosDenom
+// tokenDenom returns the Injective denom of the token. If it's neither mapped nor was looked up,
+// the peggy denom is returned and resolved is false.
+func (r *batchRequester) tokenDenom(tokenAddr eth.Address) (denom string, resolved bool) {
+	if cosmosDenom, ok := r.erc20ContractMapping.Denom(tokenAddr); ok {
+		return cosmosDenom, true
+	}
+
+	if denom, ok := r.denoms[tokenAddr]; ok {
+		return denom, true
 	}
 
 	// peggy denom
-	return types.PeggyDenomString(tokenAddr)
+	return types.PeggyDenomString(tokenAddr), false
+}
+
+// resolveDenoms looks up on Injective the denoms of the queued tokens the mapping doesn't know.
+// The mapping is refreshed every few minutes only, so a Cosmos token deployed in between would
+// otherwise be requested under its peggy denom. The origin of a token never changes, so the
+// lookups are cached.
+func (r *batchRequester) resolveDenoms(ctx context.Context, injective InjectiveNetwork, unbatchedFees []*types.BatchFees) {
+	for _, tokenFee := range unbatchedFees {
+		tokenAddr := eth.HexToAddress(tokenFee.Token)
+		if _, resolved := r.tokenDenom(tokenAddr); resolved {
+			continue
+		}
+
+		denom, _, err := injective.ERC20ToDenom(ctx, tokenAddr)
+		if err != nil {
+			r.log.WithError(err).WithField("token_contract", tokenAddr.String()).Warningln("unable to get the denom of the token from Injective")
+			continue
+		}
+
+		if r.denoms == nil {
+			r.denoms = make(map[eth.Address]string)
+		}
+
+		r.denoms[tokenAddr] = denom
+	}
 }
 
 func (r *batchRequester) checkFeeThreshold(
 	feed PriceFeed,
 	tokenAddr eth.Address,
 	totalFees cosmtypes.Int,
+	decimals uint8,
+	minFeeUSD float64,
 ) bool {
-	if r.minBatchFee == 0 {
+	if minFeeUSD == 0 {
 		return true
 	}
 
@@ -132,8 +449,8 @@ func (r *batchRequester) checkFeeThreshold(
 	}
 
 	tokenPriceInUSDDec := decimal.NewFromFloat(tokenPriceInUSD)
-	totalFeeInUSDDec := decimal.NewFromBigInt(totalFees.BigInt(), -18).Mul(tokenPriceInUSDDec)
-	minFeeInUSDDec := decimal.NewFromFloat(r.minBatchFee)
+	totalFeeInUSDDec := decimal.NewFromBigInt(totalFees.BigInt(), -int32(decimals)).Mul(tokenPriceInUSDDec)
+	minFeeInUSDDec := decimal.NewFromFloat(minFeeUSD)
 
 	if totalFeeInUSDDec.GreaterThan(minFeeInUSDDec) {
 		return true
@@ -3,7 +3,9 @@ package orchestrator
 import (
 	"context"
 	"errors"
+	"math/big"
 	"testing"
+	"time"
 
 	eth "github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
@@ -13,6 +15,14 @@ import (
 	cosmtypes "github.com/cosmos/cosmos-sdk/types"
 )
 
+func ethWithDecimals(decimals uint8) mockEthereum {
+	return mockEthereum{
+		tokenDecimalsFn: func(context.Context, eth.Address) (uint8, error) {
+			return decimals, nil
+		},
+	}
+}
+
 func TestRequestBatches(t *testing.T) {
 	t.Parallel()
 
@@ -31,7 +41,7 @@ func TestRequestBatches(t *testing.T) {
 		}
 		feed := mockPriceFeed{}
 
-		assert.NoError(t, r.run(context.TODO(), inj, feed))
+		assert.NoError(t, r.run(context.TODO(), inj, ethWithDecimals(18), feed))
 	})
 
 	t.Run("no unbatched tokens", func(t *testing.T) {
@@ -49,7 +59,7 @@ func TestRequestBatches(t *testing.T) {
 		}
 		feed := mockPriceFeed{}
 
-		assert.NoError(t, r.run(context.TODO(), inj, feed))
+		assert.NoError(t, r.run(context.TODO(), inj, ethWithDecimals(18), feed))
 	})
 
 	t.Run("batch does not meet fee threshold", func(t *testing.T) {
@@ -61,9 +71,9 @@ func TestRequestBatches(t *testing.T) {
 			log:         suplog.DefaultLogger,
 			minBatchFee: 51.0,
 			retries:     1,
-			erc20ContractMapping: map[eth.Address]string{
+			erc20ContractMapping: NewTokenMapping(map[eth.Address]string{
 				eth.HexToAddress(tokenAddr): "inj",
-			},
+			}),
 		}
 
 		inj := &mockInjective{
@@ -81,7 +91,7 @@ func TestRequestBatches(t *testing.T) {
 
 		feed := mockPriceFeed{queryFn: func(_ eth.Address) (float64, error) { return 1, nil }}
 
-		assert.NoError(t, r.run(context.TODO(), inj, feed))
+		assert.NoError(t, r.run(context.TODO(), inj, ethWithDecimals(18), feed))
 		assert.Equal(t, inj.sendRequestBatchCallCount, 0)
 	})
 
@@ -94,9 +104,9 @@ func TestRequestBatches(t *testing.T) {
 			log:         suplog.DefaultLogger,
 			minBatchFee: 49.0,
 			retries:     1,
-			erc20ContractMapping: map[eth.Address]string{
+			erc20ContractMapping: NewTokenMapping(map[eth.Address]string{
 				eth.HexToAddress(tokenAddr): "inj",
-			},
+			}),
 		}
 
 		inj := &mockInjective{
@@ -114,10 +124,294 @@ func TestRequestBatches(t *testing.T) {
 
 		feed := mockPriceFeed{queryFn: func(_ eth.Address) (float64, error) { return 1, nil }}
 
-		assert.NoError(t, r.run(context.TODO(), inj, feed))
+		assert.NoError(t, r.run(context.TODO(), inj, ethWithDecimals(18), feed))
 		assert.Equal(t, inj.sendRequestBatchCallCount, 1)
 	})
 
+	t.Run("token is ignored by policy", func(t *testing.T) {
+		t.Parallel()
+
+		tokenAddr := eth.HexToAddress("0xe28b3B32B6c345A34Ff64674606124Dd5Aceca30")
+
+		r := &batchRequester{
+			log:     suplog.DefaultLogger,
+			retries: 1,
+			policy: &BatchPolicy{rules: map[string]BatchRule{
+				policyKey("inj"): {Ignore: true},
+			}},
+			erc20ContractMapping: NewTokenMapping(map[eth.Address]string{tokenAddr: "inj"}),
+		}
+
+		inj := &mockInjective{
+			sendRequestBatchFn: func(context.Context, string) error { return nil },
+			unbatchedTokenFeesFn: func(_ context.Context) ([]*peggy.BatchFees, error) {
+				fees, _ := cosmtypes.NewIntFromString("50000000000000000000")
+				return []*peggy.BatchFees{{Token: tokenAddr.String(), TotalFees: fees}}, nil
+			},
+		}
+
+		feed := mockPriceFeed{queryFn: func(_ eth.Address) (float64, error) { return 1, nil }}
+
+		assert.NoError(t, r.run(context.TODO(), inj, ethWithDecimals(18), feed))
+		assert.Equal(t, inj.sendRequestBatchCallCount, 0)
+	})
+
+	t.Run("min tx count is not met", func(t *testing.T) {
+		t.Parallel()
+
+		tokenAddr := eth.HexToAddress("0xe28b3B32B6c345A34Ff64674606124Dd5Aceca30")
+
+		r := &batchRequester{
+			log:     suplog.DefaultLogger,
+			retries: 1,
+			policy: &BatchPolicy{rules: map[string]BatchRule{
+				policyKey(tokenAddr.Hex()): {MinTxCount: 3},
+			}},
+		}
+
+		inj := &mockInjective{
+			erc20ToDenomFn:     erc20Originated,
+			sendRequestBatchFn: func(context.Context, string) error { return nil },
+			unbatchedTokenFeesFn: func(_ context.Context) ([]*peggy.BatchFees, error) {
+				fees, _ := cosmtypes.NewIntFromString("50000000000000000000")
+				return []*peggy.BatchFees{{Token: tokenAddr.String(), TotalFees: fees}}, nil
+			},
+			unbatchedTransfersFn: func(context.Context) ([]*peggy.OutgoingTransferTx, error) {
+				return []*peggy.OutgoingTransferTx{
+					{Id: 1, Erc20Token: &peggy.ERC20Token{Contract: tokenAddr.Hex()}},
+					{Id: 2, Erc20Token: &peggy.ERC20Token{Contract: tokenAddr.Hex()}},
+					{Id: 3, Erc20Token: &peggy.ERC20Token{Contract: "0x0000000000000000000000000000000000000001"}},
+				}, nil
+			},
+		}
+
+		feed := mockPriceFeed{queryFn: func(_ eth.Address) (float64, error) { return 1, nil }}
+
+		assert.NoError(t, r.run(context.TODO(), inj, ethWithDecimals(18), feed))
+		assert.Equal(t, inj.sendRequestBatchCallCount, 0)
+	})
+
+	t.Run("min raw fee is not met", func(t *testing.T) {
+		t.Parallel()
+
+		tokenAddr := eth.HexToAddress("0xe28b3B32B6c345A34Ff64674606124Dd5Aceca30")
+
+		r := &batchRequester{
+			log:     suplog.DefaultLogger,
+			retries: 1,
+			policy: &BatchPolicy{rules: map[string]BatchRule{
+				policyKey(tokenAddr.Hex()): {MinFee: big.NewInt(1000)},
+			}},
+		}
+
+		inj := &mockInjective{
+			erc20ToDenomFn:     erc20Originated,
+			sendRequestBatchFn: func(context.Context, string) error { return nil },
+			unbatchedTokenFeesFn: func(_ context.Context) ([]*peggy.BatchFees, error) {
+				return []*peggy.BatchFees{{Token: tokenAddr.String(), TotalFees: cosmtypes.NewInt(999)}}, nil
+			},
+		}
+
+		feed := mockPriceFeed{queryFn: func(_ eth.Address) (float64, error) { return 1, nil }}
+
+		assert.NoError(t, r.run(context.TODO(), inj, ethWithDecimals(18), feed))
+		assert.Equal(t, inj.sendRequestBatchCallCount, 0)
+	})
+
+	t.Run("underpriced batch is requested after max age", func(t *testing.T) {
+		t.Parallel()
+
+		tokenAddr := eth.HexToAddress("0xe28b3B32B6c345A34Ff64674606124Dd5Aceca30")
+
+		r := &batchRequester{
+			log:     suplog.DefaultLogger,
+			retries: 1,
+			policy: &BatchPolicy{rules: map[string]BatchRule{
+				policyKey(tokenAddr.Hex()): {MinFeeUSD: 100, MaxAge: time.Hour},
+			}},
+		}
+
+		fees, _ := cosmtypes.NewIntFromString("1000000000000000000")
+		inj := &mockInjective{
+			erc20ToDenomFn:     erc20Originated,
+			sendRequestBatchFn: func(context.Context, string) error { return nil },
+			unbatchedTokenFeesFn: func(_ context.Context) ([]*peggy.BatchFees, error) {
+				return []*peggy.BatchFees{{Token: tokenAddr.String(), TotalFees: fees}}, nil
+			},
+			unbatchedTransfersFn: func(context.Context) ([]*peggy.OutgoingTransferTx, error) {
+				return []*peggy.OutgoingTransferTx{
+					{Id: 8, Erc20Token: &peggy.ERC20Token{Contract: tokenAddr.Hex()}, Erc20Fee: &peggy.ERC20Token{Contract: tokenAddr.Hex(), Amount: fees}},
+				}, nil
+			},
+			transferCreatedAtFn: func(_ context.Context, id uint64) (time.Time, error) {
+				assert.Equal(t, uint64(8), id)
+				return time.Now().Add(-2 * time.Hour), nil
+			},
+		}
+
+		feed := mockPriceFeed{queryFn: func(_ eth.Address) (float64, error) { return 1, nil }}
+
+		assert.NoError(t, r.run(context.TODO(), inj, ethWithDecimals(18), feed))
+		assert.Equal(t, inj.sendRequestBatchCallCount, 1)
+
+		// the pool is read again once a batch is requested
+		assert.Nil(t, r.pool)
+	})
+
+	t.Run("unbatched transfers are read only when the queued fees change", func(t *testing.T) {
+		t.Parallel()
+
+		tokenAddr := eth.HexToAddress("0xe28b3B32B6c345A34Ff64674606124Dd5Aceca30")
+
+		r := &batchRequester{
+			log:     suplog.DefaultLogger,
+			retries: 1,
+			policy: &BatchPolicy{rules: map[string]BatchRule{
+				policyKey(tokenAddr.Hex()): {MinTxCount: 3},
+			}},
+		}
+
+		fees := cosmtypes.NewInt(100)
+		inj := &mockInjective{
+			erc20ToDenomFn: erc20Originated,
+			unbatchedTokenFeesFn: func(_ context.Context) ([]*peggy.BatchFees, error) {
+				return []*peggy.BatchFees{{Token: tokenAddr.String(), TotalFees: fees}}, nil
+			},
+			unbatchedTransfersFn: func(context.Context) ([]*peggy.OutgoingTransferTx, error) {
+				return []*peggy.OutgoingTransferTx{
+					{Id: 1, Erc20Token: &peggy.ERC20Token{Contract: tokenAddr.Hex()}, Erc20Fee: &peggy.ERC20Token{Contract: tokenAddr.Hex(), Amount: cosmtypes.NewInt(100)}},
+				}, nil
+			},
+		}
+
+		feed := mockPriceFeed{queryFn: func(_ eth.Address) (float64, error) { return 1, nil }}
+
+		assert.NoError(t, r.run(context.TODO(), inj, ethWithDecimals(18), feed))
+		assert.NoError(t, r.run(context.TODO(), inj, ethWithDecimals(18), feed))
+		assert.Equal(t, 1, inj.unbatchedTransfersCallCount)
+
+		// a new withdrawal was queued
+		fees = cosmtypes.NewInt(150)
+		assert.NoError(t, r.run(context.TODO(), inj, ethWithDecimals(18), feed))
+		assert.Equal(t, 2, inj.unbatchedTransfersCallCount)
+	})
+
+	t.Run("dynamic fee follows the cost of relaying", func(t *testing.T) {
+		t.Parallel()
+
+		tokenAddr := eth.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+		weth := eth.HexToAddress("0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2")
+
+		for _, tc := range []struct {
+			gasPrice int64
+			requests int
+		}{
+			{gasPrice: 10e9, requests: 1}, // 300000 gas * 10 gwei * 2000 USD = 6 USD
+			{gasPrice: 50e9, requests: 0}, // 30 USD
+		} {
+			r := &batchRequester{
+				log:         suplog.DefaultLogger,
+				minBatchFee: 5,
+				retries:     1,
+				dynamicFee: &DynamicBatchFee{
+					BaseGas:     200000,
+					GasPerTx:    50000,
+					Multiplier:  1,
+					WETHAddress: weth,
+				},
+			}
+
+			inj := &mockInjective{
+				erc20ToDenomFn:     erc20Originated,
+				sendRequestBatchFn: func(context.Context, string) error { return nil },
+				unbatchedTokenFeesFn: func(_ context.Context) ([]*peggy.BatchFees, error) {
+					return []*peggy.BatchFees{{Token: tokenAddr.String(), TotalFees: cosmtypes.NewInt(10000000)}}, nil
+				},
+				unbatchedTransfersFn: func(context.Context) ([]*peggy.OutgoingTransferTx, error) {
+					return []*peggy.OutgoingTransferTx{
+						{Id: 1, Erc20Token: &peggy.ERC20Token{Contract: tokenAddr.Hex()}},
+						{Id: 2, Erc20Token: &peggy.ERC20Token{Contract: tokenAddr.Hex()}},
+					}, nil
+				},
+			}
+
+			gasPrice := tc.gasPrice
+			ethereum := ethWithDecimals(6)
+			ethereum.batchGasPriceFn = func(context.Context) (*big.Int, error) { return big.NewInt(gasPrice), nil }
+
+			// 10 USDT in fees
+			feed := mockPriceFeed{queryFn: func(addr eth.Address) (float64, error) {
+				if addr == weth {
+					return 2000, nil
+				}
+
+				return 1, nil
+			}}
+
+			assert.NoError(t, r.run(context.TODO(), inj, ethereum, feed))
+			assert.Equal(t, tc.requests, inj.sendRequestBatchCallCount, "gas price %d", tc.gasPrice)
+		}
+	})
+
+	t.Run("denom of a token missing from the mapping is looked up once", func(t *testing.T) {
+		t.Parallel()
+
+		tokenAddr := eth.HexToAddress("0xe28b3B32B6c345A34Ff64674606124Dd5Aceca30")
+
+		r := &batchRequester{
+			log:                  suplog.DefaultLogger,
+			retries:              1,
+			erc20ContractMapping: NewTokenMapping(nil),
+		}
+
+		inj := &mockInjective{
+			erc20ToDenomFn: func(_ context.Context, token eth.Address) (string, bool, error) {
+				assert.Equal(t, tokenAddr, token)
+				return "factory/inj1abc/atom", true, nil
+			},
+			sendRequestBatchFn: func(_ context.Context, denom string) error {
+				assert.Equal(t, "factory/inj1abc/atom", denom)
+				return nil
+			},
+			unbatchedTokenFeesFn: func(_ context.Context) ([]*peggy.BatchFees, error) {
+				return []*peggy.BatchFees{{Token: tokenAddr.String(), TotalFees: cosmtypes.NewInt(100)}}, nil
+			},
+		}
+
+		assert.NoError(t, r.run(context.TODO(), inj, ethWithDecimals(18), mockPriceFeed{}))
+		assert.NoError(t, r.run(context.TODO(), inj, ethWithDecimals(18), mockPriceFeed{}))
+		assert.Equal(t, 2, inj.sendRequestBatchCallCount)
+		assert.Equal(t, 1, inj.erc20ToDenomCallCount)
+	})
+
+	t.Run("batch is not requested while the denom of the token is unknown", func(t *testing.T) {
+		t.Parallel()
+
+		tokenAddr := eth.HexToAddress("0xe28b3B32B6c345A34Ff64674606124Dd5Aceca30")
+
+		r := &batchRequester{
+			log:     suplog.DefaultLogger,
+			retries: 1,
+		}
+
+		inj := &mockInjective{
+			erc20ToDenomFn: func(context.Context, eth.Address) (string, bool, error) {
+				return "", false, errors.New("fail")
+			},
+			sendRequestBatchFn: func(context.Context, string) error { return nil },
+			unbatchedTokenFeesFn: func(_ context.Context) ([]*peggy.BatchFees, error) {
+				return []*peggy.BatchFees{{Token: tokenAddr.String(), TotalFees: cosmtypes.NewInt(100)}}, nil
+			},
+		}
+
+		assert.NoError(t, r.run(context.TODO(), inj, ethWithDecimals(18), mockPriceFeed{}))
+		assert.Equal(t, 0, inj.sendRequestBatchCallCount)
+	})
+}
+
+// erc20Originated resolves tokens the way Injective does for Ethereum-originated ones.
+func erc20Originated(_ context.Context, tokenAddr eth.Address) (string, bool, error) {
+	return peggy.PeggyDenomString(tokenAddr), false, nil
 }
 
 func TestCheckFeeThreshold(t *testing.T) {
@@ -136,7 +430,7 @@ func TestCheckFeeThreshold(t *testing.T) {
 		)
 
 		// 2.5 * 10 > 21
-		assert.True(t, requester.checkFeeThreshold(feed, tokenAddr, totalFees))
+		assert.True(t, requester.checkFeeThreshold(feed, tokenAddr, totalFees, 18, requester.minBatchFee))
 	})
 
 	t.Run("fee threshold is met", func(t *testing.T) {
@@ -152,6 +446,60 @@ func TestCheckFeeThreshold(t *testing.T) {
 		)
 
 		// 2.5 * 100 < 333.333
-		assert.False(t, requester.checkFeeThreshold(feed, tokenAddr, totalFees))
+		assert.False(t, requester.checkFeeThreshold(feed, tokenAddr, totalFees, 18, requester.minBatchFee))
+	})
+
+	t.Run("fee threshold respects token decimals", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			requester = &batchRequester{}
+			tokenAddr = eth.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+			feed      = mockPriceFeed{queryFn: func(_ eth.Address) (float64, error) {
+				return 1, nil
+			}}
+		)
+
+		for _, tc := range []struct {
+			decimals  uint8
+			totalFees cosmtypes.Int
+		}{
+			{decimals: 6, totalFees: cosmtypes.NewInt(25000000)},           // 25 USDT
+			{decimals: 8, totalFees: cosmtypes.NewInt(2500000000)},         // 25 tokens with 8 decimals
+			{decimals: 18, totalFees: cosmtypes.NewIntWithDecimal(25, 18)}, // 25 DAI
+		} {
+			// 25 > 24.9
+			assert.True(t, requester.checkFeeThreshold(feed, tokenAddr, tc.totalFees, tc.decimals, 24.9), "decimals %d", tc.decimals)
+			// 25 < 25.1
+			assert.False(t, requester.checkFeeThreshold(feed, tokenAddr, tc.totalFees, tc.decimals, 25.1), "decimals %d", tc.decimals)
+		}
+
+		// 25 USDT read as an 18 decimals token would be worth nothing
+		assert.False(t, requester.checkFeeThreshold(feed, tokenAddr, cosmtypes.NewInt(25000000), 18, 24.9))
+	})
+
+	t.Run("6 decimals batch is requested", func(t *testing.T) {
+		t.Parallel()
+
+		tokenAddr := eth.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+
+		r := &batchRequester{
+			log:         suplog.DefaultLogger,
+			minBatchFee: 20,
+			retries:     1,
+		}
+
+		inj := &mockInjective{
+			erc20ToDenomFn:     erc20Originated,
+			sendRequestBatchFn: func(context.Context, string) error { return nil },
+			unbatchedTokenFeesFn: func(_ context.Context) ([]*peggy.BatchFees, error) {
+				return []*peggy.BatchFees{{Token: tokenAddr.String(), TotalFees: cosmtypes.NewInt(25000000)}}, nil
+			},
+		}
+
+		feed := mockPriceFeed{queryFn: func(_ eth.Address) (float64, error) { return 1, nil }}
+
+		assert.NoError(t, r.run(context.TODO(), inj, ethWithDecimals(6), feed))
+		assert.Equal(t, inj.sendRequestBatchCallCount, 1)
 	})
 }
@@ -0,0 +1,263 @@
+package orchestrator
+
+import (
+	"context"
+	"sync/atomic"
+
+	eth "github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	log "github.com/xlab/suplog"
+
+	"github.com/InjectiveLabs/peggo/orchestrator/alerts"
+	"github.com/InjectiveLabs/peggo/orchestrator/ethereum/peggy"
+	"github.com/InjectiveLabs/peggo/orchestrator/loops"
+	"github.com/InjectiveLabs/peggo/orchestrator/metrics"
+	"github.com/InjectiveLabs/sdk-go/chain/peggy/types"
+)
+
+// signerBehindBlocks is the age in Injective blocks of the oldest valset or batch not yet
+// signed by our validator above which a warning is raised, well before the signing window ends.
+const signerBehindBlocks = 1000
+
+// BridgeMetricsLoop updates the bridge health gauges: how far the orchestrator is behind
+// Ethereum and how much work is waiting for it or for the relayers.
+func (s *PeggyOrchestrator) BridgeMetricsLoop(ctx context.Context, validatorMode bool) error {
+	m := &bridgeMetrics{
+		log:           log.WithField("loop", "BridgeMetrics"),
+		validatorMode: validatorMode,
+		ethSignerAddr: s.ethSignerAddr,
+		oracleHeight:  func() uint64 { return atomic.LoadUint64(&s.oracleEthHeight) },
+	}
+
+	return loops.RunLoop(
+		ctx,
+		"BridgeMetrics",
+		defaultLoopDur,
+		func() error { return m.run(ctx, s.injective, s.ethereum) },
+	)
+}
+
+type bridgeMetrics struct {
+	log           log.Logger
+	validatorMode bool
+	ethSignerAddr eth.Address
+	// oracleHeight is the last Ethereum block scanned by the oracle, zero until its first run.
+	oracleHeight func() uint64
+}
+
+func (m *bridgeMetrics) run(
+	ctx context.Context,
+	injective InjectiveNetwork,
+	ethereum EthereumNetwork,
+) error {
+	injHeight, err := injective.GetLatestBlockHeight(ctx)
+	if err != nil {
+		// non-fatal, the gauges are updated on the next iteration
+		m.log.WithError(err).Warningln("unable to get latest Injective height")
+		return nil
+	}
+
+	// gauges are independent, so one failing query doesn't hold back the others
+	if m.validatorMode {
+		if err := m.updateEventLag(ctx, injHeight, injective, ethereum); err != nil {
+			m.log.WithError(err).Warningln("unable to update event lag gauges")
+		}
+
+		if err := m.updateUnsignedValsets(ctx, injHeight, injective); err != nil {
+			m.log.WithError(err).Warningln("unable to update unsigned valset gauges")
+		}
+	}
+
+	if err := m.updateBatches(ctx, injHeight, injective, ethereum); err != nil {
+		m.log.WithError(err).Warningln("unable to update batch gauges")
+	}
+
+	return nil
+}
+
+func (m *bridgeMetrics) updateEventLag(
+	ctx context.Context,
+	injHeight int64,
+	injective InjectiveNetwork,
+	ethereum EthereumNetwork,
+) error {
+	contractNonce, err := ethereum.GetLastEventNonce(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get last event nonce from Ethereum")
+	}
+
+	lastClaimEvent, err := injective.LastClaimEvent(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get last claim event from Injective")
+	}
+
+	eventLag := int64(contractNonce.Uint64()) - int64(lastClaimEvent.EthereumEventNonce)
+	if eventLag < 0 {
+		eventLag = 0
+	}
+
+	metrics.EventNonceLag.Set(injHeight, float64(eventLag))
+
+	oracleHeight := m.oracleHeight()
+	if oracleHeight == 0 {
+		return nil
+	}
+
+	latestHeader, err := ethereum.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to get latest Ethereum header")
+	}
+
+	var blockLag uint64
+	if latest := latestHeader.Number.Uint64(); latest > oracleHeight {
+		blockLag = latest - oracleHeight
+	}
+
+	metrics.EthBlockLag.Set(injHeight, float64(blockLag))
+
+	return nil
+}
+
+func (m *bridgeMetrics) updateUnsignedValsets(ctx context.Context, injHeight int64, injective InjectiveNetwork) error {
+	valsets, err := injective.OldestUnsignedValsets(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get unsigned valsets")
+	}
+
+	var oldest uint64
+	for _, vs := range valsets {
+		if oldest == 0 || vs.Height < oldest {
+			oldest = vs.Height
+		}
+	}
+
+	age := blockAge(injHeight, oldest)
+
+	metrics.UnsignedValsets.Set(injHeight, float64(len(valsets)))
+	metrics.UnsignedValsetAgeBlocks.Set(injHeight, age)
+
+	m.warnSignerBehind("valsets", len(valsets), age)
+
+	return nil
+}
+
+func (m *bridgeMetrics) updateBatches(
+	ctx context.Context,
+	injHeight int64,
+	injective InjectiveNetwork,
+	ethereum EthereumNetwork,
+) error {
+	batches, err := injective.LatestTransactionBatches(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get batches from Injective")
+	}
+
+	// the contract checks the signatures against the valset it holds, which lags behind
+	// the latest one on Injective until a relayer submits the update
+	var ethValset *types.Valset
+	if len(batches) > 0 {
+		if ethValset, err = FindLatestValsetOnEth(ctx, injective, ethereum); err != nil {
+			return errors.Wrap(err, "failed to find the latest valset on Ethereum")
+		}
+	}
+
+	var (
+		unsigned, unexecuted int
+		oldestUnsigned       uint64
+		ethBatchNonces       = make(map[eth.Address]uint64)
+	)
+
+	for _, batch := range batches {
+		tokenAddr := eth.HexToAddress(batch.TokenContract)
+
+		sigs, err := injective.TransactionBatchSignatures(ctx, batch.BatchNonce, tokenAddr)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get signatures of batch %d", batch.BatchNonce)
+		}
+
+		if m.validatorMode && !signedBy(sigs, m.ethSignerAddr) {
+			unsigned++
+			if oldestUnsigned == 0 || batch.Block < oldestUnsigned {
+				oldestUnsigned = batch.Block
+			}
+		}
+
+		if !batchConfirmed(ethValset, sigs) {
+			continue
+		}
+
+		ethNonce, ok := ethBatchNonces[tokenAddr]
+		if !ok {
+			nonce, err := ethereum.GetTxBatchNonce(ctx, tokenAddr)
+			if err != nil {
+				return errors.Wrapf(err, "failed to get batch nonce of %s from Ethereum", tokenAddr.Hex())
+			}
+
+			ethNonce = nonce.Uint64()
+			ethBatchNonces[tokenAddr] = ethNonce
+		}
+
+		if batch.BatchNonce > ethNonce {
+			unexecuted++
+		}
+	}
+
+	if m.validatorMode {
+		age := blockAge(injHeight, oldestUnsigned)
+
+		metrics.UnsignedBatches.Set(injHeight, float64(unsigned))
+		metrics.UnsignedBatchAgeBlocks.Set(injHeight, age)
+
+		m.warnSignerBehind("batches", unsigned, age)
+	}
+
+	metrics.UnexecutedBatches.Set(injHeight, float64(unexecuted))
+
+	return nil
+}
+
+// warnSignerBehind raises an alert while the signer is still running but falls behind,
+// e.g. stuck retrying, unsigned valsets and batches eventually get the validator slashed.
+func (m *bridgeMetrics) warnSignerBehind(kind string, unsigned int, ageBlocks float64) {
+	if ageBlocks <= signerBehindBlocks {
+		return
+	}
+
+	fields := log.Fields{
+		"eth_signer": m.ethSignerAddr.Hex(),
+		"unsigned":   unsigned,
+		"age_blocks": ageBlocks,
+	}
+
+	m.log.WithFields(fields).Warningf("oldest unsigned %s is more than %d blocks old", kind, signerBehindBlocks)
+	alerts.Warning("signer_behind_"+kind, "EthSigner is behind confirming "+kind+", the validator may get slashed", fields)
+}
+
+func signedBy(sigs []*types.MsgConfirmBatch, ethSigner eth.Address) bool {
+	for _, sig := range sigs {
+		if eth.HexToAddress(sig.EthSigner) == ethSigner {
+			return true
+		}
+	}
+
+	return false
+}
+
+// batchConfirmed tells if the signatures hold enough of the power of the valset on Ethereum to be relayed.
+func batchConfirmed(valset *types.Valset, sigs []*types.MsgConfirmBatch) bool {
+	signers := make([]string, len(sigs))
+	for i, sig := range sigs {
+		signers[i] = sig.EthSigner
+	}
+
+	return peggy.SignedPowerPercent(valset, signers) >= 66
+}
+
+// blockAge is the number of Injective blocks since createdAt, zero if nothing is pending.
+func blockAge(injHeight int64, createdAt uint64) float64 {
+	if createdAt == 0 || int64(createdAt) > injHeight {
+		return 0
+	}
+
+	return float64(injHeight - int64(createdAt))
+}
@@ -0,0 +1,106 @@
+package orchestrator
+
+import (
+	"context"
+	"io"
+	"math/big"
+	"net/http/httptest"
+	"testing"
+
+	eth "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xlab/suplog"
+
+	"github.com/InjectiveLabs/peggo/orchestrator/metrics"
+	wrappers "github.com/InjectiveLabs/peggo/solidity/wrappers/Peggy.sol"
+	"github.com/InjectiveLabs/sdk-go/chain/peggy/types"
+)
+
+func TestBridgeMetrics(t *testing.T) {
+	ourSigner := eth.HexToAddress("0x9924e52Fe6B833657335C4a71c8347fb2750742b")
+	otherSigner := eth.HexToAddress("0x8D983cb9388EaC77af0474fA441C4815500Cb7BB")
+	token := eth.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+
+	inj := &mockInjective{
+		getLatestBlockHeightFn: func(context.Context) (int64, error) {
+			return 100, nil
+		},
+		lastClaimEventFn: func(context.Context) (*types.LastClaimEvent, error) {
+			return &types.LastClaimEvent{EthereumEventNonce: 12}, nil
+		},
+		oldestUnsignedValsetsFn: func(context.Context) ([]*types.Valset, error) {
+			return []*types.Valset{{Nonce: 3, Height: 95}, {Nonce: 2, Height: 90}}, nil
+		},
+		valsetAtFn: func(context.Context, uint64) (*types.Valset, error) {
+			return &types.Valset{Nonce: 7}, nil
+		},
+		latestTransactionBatchesFn: func(context.Context) ([]*types.OutgoingTxBatch, error) {
+			return []*types.OutgoingTxBatch{
+				{BatchNonce: 5, Block: 80, TokenContract: token.Hex()},
+				{BatchNonce: 4, Block: 70, TokenContract: token.Hex()},
+			}, nil
+		},
+		transactionBatchSignaturesFn: func(_ context.Context, nonce uint64, _ eth.Address) ([]*types.MsgConfirmBatch, error) {
+			if nonce == 4 {
+				return []*types.MsgConfirmBatch{{EthSigner: otherSigner.Hex()}, {EthSigner: ourSigner.Hex()}}, nil
+			}
+
+			return []*types.MsgConfirmBatch{{EthSigner: otherSigner.Hex()}}, nil
+		},
+	}
+
+	ethereum := mockEthereum{
+		getLastEventNonceFn: func(context.Context) (*big.Int, error) {
+			return big.NewInt(15), nil
+		},
+		headerByNumberFn: func(context.Context, *big.Int) (*ethtypes.Header, error) {
+			return &ethtypes.Header{Number: big.NewInt(1200)}, nil
+		},
+		getTxBatchNonceFn: func(context.Context, eth.Address) (*big.Int, error) {
+			return big.NewInt(4), nil
+		},
+		getValsetNonceFn: func(context.Context) (*big.Int, error) {
+			return big.NewInt(7), nil
+		},
+		// batches are confirmed against the valset held by the contract, not the latest one on Injective
+		getValsetUpdatedEventsFn: func(uint64, uint64) ([]*wrappers.PeggyValsetUpdatedEvent, error) {
+			return []*wrappers.PeggyValsetUpdatedEvent{{
+				NewValsetNonce: big.NewInt(7),
+				RewardAmount:   big.NewInt(0),
+				Validators:     []eth.Address{otherSigner, ourSigner},
+				Powers:         []*big.Int{big.NewInt(3000000000), big.NewInt(1294967295)},
+			}}, nil
+		},
+	}
+
+	m := &bridgeMetrics{
+		log:           suplog.DefaultLogger,
+		validatorMode: true,
+		ethSignerAddr: ourSigner,
+		oracleHeight:  func() uint64 { return 1000 },
+	}
+
+	require.NoError(t, m.run(context.Background(), inj, ethereum))
+
+	rec := httptest.NewRecorder()
+	metrics.Handler().ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+
+	body, err := io.ReadAll(rec.Body)
+	require.NoError(t, err)
+
+	for _, line := range []string{
+		"peggo_bridge_event_nonce_lag 3",
+		"peggo_bridge_eth_block_lag 200",
+		"peggo_bridge_unsigned_valsets 2",
+		"peggo_bridge_unsigned_valset_age_blocks 10",
+		"peggo_bridge_unsigned_batches 1",
+		"peggo_bridge_unsigned_batch_age_blocks 20",
+		// batch 4 is already executed, batch 5 is confirmed by 70% of the power on Ethereum
+		"peggo_bridge_unexecuted_batches 1",
+		`peggo_bridge_injective_height{gauge="event_nonce_lag"} 100`,
+	} {
+		assert.Contains(t, string(body), line)
+	}
+}
@@ -0,0 +1,302 @@
+package chainlink
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	log "github.com/xlab/suplog"
+
+	"github.com/InjectiveLabs/peggo/orchestrator/metrics"
+)
+
+// AggregatorV3ABI is the subset of Chainlink AggregatorV3Interface used to read prices.
+const AggregatorV3ABI = `[
+	{"inputs":[],"name":"decimals","outputs":[{"internalType":"uint8","name":"","type":"uint8"}],"stateMutability":"view","type":"function"},
+	{"inputs":[],"name":"latestRoundData","outputs":[
+		{"internalType":"uint80","name":"roundId","type":"uint80"},
+		{"internalType":"int256","name":"answer","type":"int256"},
+		{"internalType":"uint256","name":"startedAt","type":"uint256"},
+		{"internalType":"uint256","name":"updatedAt","type":"uint256"},
+		{"internalType":"uint80","name":"answeredInRound","type":"uint80"}
+	],"stateMutability":"view","type":"function"}
+]`
+
+const (
+	QuoteUSD = "usd"
+	QuoteETH = "eth"
+
+	defaultMaxAge = 25 * time.Hour
+	callTimeout   = 10 * time.Second
+)
+
+var aggregatorABI, _ = abi.JSON(strings.NewReader(AggregatorV3ABI))
+
+// Feed is a Chainlink aggregator that prices a token.
+type Feed struct {
+	Aggregator common.Address
+	// Quote is the currency of the aggregator answer, either usd or eth.
+	Quote string
+	// MaxAge is how old the latest round may be before the price is considered stale.
+	MaxAge time.Duration
+}
+
+type Config struct {
+	// ETHUSDAggregator converts prices of ETH-denominated feeds to USD.
+	ETHUSDAggregator common.Address
+	// Feeds maps ERC20 token contracts to their aggregators.
+	Feeds map[common.Address]Feed
+	// MaxAge is used for feeds without their own MaxAge.
+	MaxAge time.Duration
+}
+
+type configFile struct {
+	ETHUSD string                    `json:"eth_usd"`
+	MaxAge string                    `json:"max_age"`
+	Feeds  map[string]feedConfigFile `json:"feeds"`
+}
+
+type feedConfigFile struct {
+	Aggregator string `json:"aggregator"`
+	Quote      string `json:"quote"`
+	MaxAge     string `json:"max_age"`
+}
+
+// LoadConfig reads the token to aggregator mapping from a JSON file:
+//
+//	{
+//	  "eth_usd": "0x5f4eC3Df9cbd43714FE2740F5E3616155c5b8419",
+//	  "max_age": "25h",
+//	  "feeds": {
+//	    "0xdAC17F958D2ee523a2206206994597C13D831ec7": {"aggregator": "0x3E7d1eAB13ad0104d2750B8863b489D65364e32D", "quote": "usd"},
+//	    "0xe28b3B32B6c345A34Ff64674606124Dd5Aceca30": {"aggregator": "0x...", "quote": "eth", "max_age": "2h"}
+//	  }
+//	}
+func LoadConfig(path string) (*Config, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		err = errors.Wrap(err, "failed to read Chainlink config")
+		return nil, err
+	}
+
+	var file configFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		err = errors.Wrapf(err, "failed to parse Chainlink config %s", path)
+		return nil, err
+	}
+
+	cfg := &Config{
+		Feeds: make(map[common.Address]Feed, len(file.Feeds)),
+	}
+
+	if len(file.ETHUSD) > 0 {
+		if !common.IsHexAddress(file.ETHUSD) {
+			return nil, errors.Errorf("invalid ETH/USD aggregator address %s", file.ETHUSD)
+		}
+
+		cfg.ETHUSDAggregator = common.HexToAddress(file.ETHUSD)
+	}
+
+	if cfg.MaxAge, err = parseMaxAge(file.MaxAge); err != nil {
+		return nil, err
+	}
+
+	for token, feedCfg := range file.Feeds {
+		if !common.IsHexAddress(token) || !common.IsHexAddress(feedCfg.Aggregator) {
+			return nil, errors.Errorf("invalid Chainlink feed for token %s", token)
+		}
+
+		feed := Feed{
+			Aggregator: common.HexToAddress(feedCfg.Aggregator),
+			Quote:      strings.ToLower(feedCfg.Quote),
+		}
+
+		if feed.MaxAge, err = parseMaxAge(feedCfg.MaxAge); err != nil {
+			return nil, errors.Wrapf(err, "invalid Chainlink feed for token %s", token)
+		}
+
+		cfg.Feeds[common.HexToAddress(token)] = feed
+	}
+
+	return cfg, nil
+}
+
+func parseMaxAge(s string) (time.Duration, error) {
+	if len(s) == 0 {
+		return 0, nil
+	}
+
+	maxAge, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to parse max_age")
+	}
+
+	return maxAge, nil
+}
+
+// ChainlinkPriceFeed reads token prices from Chainlink aggregators on Ethereum.
+type ChainlinkPriceFeed struct {
+	caller bind.ContractCaller
+	config *Config
+
+	decimalsMux sync.Mutex
+	decimals    map[common.Address]uint8
+
+	logger  log.Logger
+	svcTags metrics.Tags
+}
+
+func NewChainlinkPriceFeed(caller bind.ContractCaller, cfg *Config) (*ChainlinkPriceFeed, error) {
+	if cfg == nil || len(cfg.Feeds) == 0 {
+		return nil, errors.New("no Chainlink feeds configured")
+	}
+
+	config := *cfg
+	if config.MaxAge == 0 {
+		config.MaxAge = defaultMaxAge
+	}
+
+	for token, feed := range config.Feeds {
+		switch feed.Quote {
+		case QuoteUSD:
+		case QuoteETH:
+			if config.ETHUSDAggregator == (common.Address{}) {
+				return nil, errors.Errorf("feed of token %s is ETH-denominated, but no ETH/USD aggregator is set", token.Hex())
+			}
+		default:
+			return nil, errors.Errorf("feed of token %s has unsupported quote %q, expected usd or eth", token.Hex(), feed.Quote)
+		}
+	}
+
+	return &ChainlinkPriceFeed{
+		caller:   caller,
+		config:   &config,
+		decimals: make(map[common.Address]uint8),
+		logger: log.WithFields(log.Fields{
+			"svc":      "oracle",
+			"provider": "chainlink",
+		}),
+		svcTags: metrics.Tags{
+			"svc":      "chainlink",
+			"provider": "chainlink",
+		},
+	}, nil
+}
+
+// SupportsToken reports whether there is a feed configured for the token.
+func (f *ChainlinkPriceFeed) SupportsToken(erc20Contract common.Address) bool {
+	_, ok := f.config.Feeds[erc20Contract]
+	return ok
+}
+
+func (f *ChainlinkPriceFeed) QueryUSDPrice(erc20Contract common.Address) (float64, error) {
+	metrics.ReportFuncCall(f.svcTags)
+	doneFn := metrics.ReportFuncTiming(f.svcTags)
+	defer doneFn()
+
+	feed, ok := f.config.Feeds[erc20Contract]
+	if !ok {
+		metrics.ReportFuncError(f.svcTags)
+		return 0, errors.Errorf("no Chainlink feed for token %s", erc20Contract.Hex())
+	}
+
+	ctx, cancelFn := context.WithTimeout(context.Background(), callTimeout)
+	defer cancelFn()
+
+	price, err := f.latestPrice(ctx, feed.Aggregator, f.maxAge(feed))
+	if err != nil {
+		metrics.ReportFuncError(f.svcTags)
+		return 0, errors.Wrapf(err, "failed to get Chainlink price of %s", erc20Contract.Hex())
+	}
+
+	if feed.Quote == QuoteETH {
+		ethPrice, err := f.latestPrice(ctx, f.config.ETHUSDAggregator, f.config.MaxAge)
+		if err != nil {
+			metrics.ReportFuncError(f.svcTags)
+			return 0, errors.Wrap(err, "failed to get Chainlink ETH/USD price")
+		}
+
+		price = price.Mul(ethPrice)
+	}
+
+	usdPrice, _ := price.Float64()
+	return usdPrice, nil
+}
+
+func (f *ChainlinkPriceFeed) maxAge(feed Feed) time.Duration {
+	if feed.MaxAge > 0 {
+		return feed.MaxAge
+	}
+
+	return f.config.MaxAge
+}
+
+// latestPrice reads the latest round of the aggregator and checks that it's complete and fresh.
+func (f *ChainlinkPriceFeed) latestPrice(ctx context.Context, aggregator common.Address, maxAge time.Duration) (decimal.Decimal, error) {
+	contract := bind.NewBoundContract(aggregator, aggregatorABI, f.caller, nil, nil)
+	opts := &bind.CallOpts{Context: ctx}
+
+	decimals, err := f.aggregatorDecimals(opts, contract, aggregator)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	var out []interface{}
+	if err := contract.Call(opts, &out, "latestRoundData"); err != nil {
+		return decimal.Zero, errors.Wrapf(err, "failed to call latestRoundData of %s", aggregator.Hex())
+	}
+
+	var (
+		roundID         = abi.ConvertType(out[0], new(big.Int)).(*big.Int)
+		answer          = abi.ConvertType(out[1], new(big.Int)).(*big.Int)
+		updatedAt       = abi.ConvertType(out[3], new(big.Int)).(*big.Int)
+		answeredInRound = abi.ConvertType(out[4], new(big.Int)).(*big.Int)
+	)
+
+	if answer.Sign() <= 0 {
+		return decimal.Zero, errors.Errorf("aggregator %s answered a non-positive price %s", aggregator.Hex(), answer)
+	}
+
+	if answeredInRound.Cmp(roundID) < 0 {
+		return decimal.Zero, errors.Errorf("aggregator %s round %s is incomplete", aggregator.Hex(), roundID)
+	}
+
+	updated := time.Unix(updatedAt.Int64(), 0)
+	if age := time.Since(updated); age > maxAge {
+		return decimal.Zero, errors.Errorf("aggregator %s price is stale, last updated at %s", aggregator.Hex(), updated.UTC())
+	}
+
+	return decimal.NewFromBigInt(answer, -int32(decimals)), nil
+}
+
+func (f *ChainlinkPriceFeed) aggregatorDecimals(opts *bind.CallOpts, contract *bind.BoundContract, aggregator common.Address) (uint8, error) {
+	f.decimalsMux.Lock()
+	decimals, ok := f.decimals[aggregator]
+	f.decimalsMux.Unlock()
+
+	if ok {
+		return decimals, nil
+	}
+
+	var out []interface{}
+	if err := contract.Call(opts, &out, "decimals"); err != nil {
+		return 0, errors.Wrapf(err, "failed to call decimals of %s", aggregator.Hex())
+	}
+
+	decimals = *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	f.decimalsMux.Lock()
+	f.decimals[aggregator] = decimals
+	f.decimalsMux.Unlock()
+
+	return decimals, nil
+}
@@ -0,0 +1,139 @@
+package chainlink
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockRound struct {
+	decimals        uint8
+	roundID         int64
+	answer          int64
+	updatedAt       time.Time
+	answeredInRound int64
+}
+
+// mockAggregators answers eth_call for Chainlink aggregators.
+type mockAggregators map[common.Address]mockRound
+
+func (m mockAggregators) CodeAt(context.Context, common.Address, *big.Int) ([]byte, error) {
+	return []byte{0x1}, nil
+}
+
+func (m mockAggregators) CallContract(_ context.Context, call ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	round, ok := m[*call.To]
+	if !ok {
+		return nil, errors.New("execution reverted")
+	}
+
+	decimalsMethod := aggregatorABI.Methods["decimals"]
+	if bytes.Equal(call.Data[:4], decimalsMethod.ID) {
+		return decimalsMethod.Outputs.Pack(round.decimals)
+	}
+
+	return aggregatorABI.Methods["latestRoundData"].Outputs.Pack(
+		big.NewInt(round.roundID),
+		big.NewInt(round.answer),
+		big.NewInt(round.updatedAt.Unix()),
+		big.NewInt(round.updatedAt.Unix()),
+		big.NewInt(round.answeredInRound),
+	)
+}
+
+var (
+	ethUSDAggregator  = common.HexToAddress("0x5f4eC3Df9cbd43714FE2740F5E3616155c5b8419")
+	usdtUSDAggregator = common.HexToAddress("0x3E7d1eAB13ad0104d2750B8863b489D65364e32D")
+	injETHAggregator  = common.HexToAddress("0x0000000000000000000000000000000000000a11")
+
+	usdtToken = common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	injToken  = common.HexToAddress("0xe28b3B32B6c345A34Ff64674606124Dd5Aceca30")
+)
+
+func TestChainlinkPriceFeed(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	cfg := &Config{
+		ETHUSDAggregator: ethUSDAggregator,
+		Feeds: map[common.Address]Feed{
+			usdtToken: {Aggregator: usdtUSDAggregator, Quote: QuoteUSD},
+			injToken:  {Aggregator: injETHAggregator, Quote: QuoteETH, MaxAge: time.Hour},
+		},
+	}
+
+	t.Run("USD and ETH denominated feeds", func(t *testing.T) {
+		t.Parallel()
+
+		caller := mockAggregators{
+			ethUSDAggregator:  {decimals: 8, roundID: 1, answer: 200000000000, updatedAt: now, answeredInRound: 1}, // 2000 USD
+			usdtUSDAggregator: {decimals: 8, roundID: 1, answer: 100010000, updatedAt: now, answeredInRound: 1},    // 1.0001 USD
+			injETHAggregator:  {decimals: 18, roundID: 1, answer: 5e15, updatedAt: now, answeredInRound: 1},        // 0.005 ETH
+		}
+
+		feed, err := NewChainlinkPriceFeed(caller, cfg)
+		assert.NoError(t, err)
+
+		price, err := feed.QueryUSDPrice(usdtToken)
+		assert.NoError(t, err)
+		assert.Equal(t, 1.0001, price)
+
+		price, err = feed.QueryUSDPrice(injToken)
+		assert.NoError(t, err)
+		assert.Equal(t, 10.0, price)
+
+		assert.False(t, feed.SupportsToken(common.HexToAddress("0x1")))
+		_, err = feed.QueryUSDPrice(common.HexToAddress("0x1"))
+		assert.Error(t, err)
+	})
+
+	t.Run("stale round", func(t *testing.T) {
+		t.Parallel()
+
+		caller := mockAggregators{
+			ethUSDAggregator: {decimals: 8, roundID: 1, answer: 200000000000, updatedAt: now, answeredInRound: 1},
+			injETHAggregator: {decimals: 18, roundID: 1, answer: 5e15, updatedAt: now.Add(-2 * time.Hour), answeredInRound: 1},
+		}
+
+		feed, err := NewChainlinkPriceFeed(caller, cfg)
+		assert.NoError(t, err)
+
+		_, err = feed.QueryUSDPrice(injToken)
+		assert.Error(t, err)
+	})
+
+	t.Run("incomplete round and bad answers", func(t *testing.T) {
+		t.Parallel()
+
+		caller := mockAggregators{
+			usdtUSDAggregator: {decimals: 8, roundID: 2, answer: 100000000, updatedAt: now, answeredInRound: 1},
+			injETHAggregator:  {decimals: 18, roundID: 1, answer: 0, updatedAt: now, answeredInRound: 1},
+			ethUSDAggregator:  {decimals: 8, roundID: 1, answer: 200000000000, updatedAt: now, answeredInRound: 1},
+		}
+
+		feed, err := NewChainlinkPriceFeed(caller, cfg)
+		assert.NoError(t, err)
+
+		_, err = feed.QueryUSDPrice(usdtToken)
+		assert.Error(t, err)
+
+		_, err = feed.QueryUSDPrice(injToken)
+		assert.Error(t, err)
+	})
+
+	t.Run("ETH feed requires ETH/USD aggregator", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewChainlinkPriceFeed(mockAggregators{}, &Config{
+			Feeds: map[common.Address]Feed{injToken: {Aggregator: injETHAggregator, Quote: QuoteETH}},
+		})
+		assert.Error(t, err)
+	})
+}
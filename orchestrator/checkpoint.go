@@ -0,0 +1,152 @@
+package orchestrator
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	eth "github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	log "github.com/xlab/suplog"
+
+	"github.com/InjectiveLabs/peggo/orchestrator/alerts"
+	"github.com/InjectiveLabs/peggo/orchestrator/ethereum/peggy"
+	"github.com/InjectiveLabs/peggo/orchestrator/loops"
+)
+
+const checkpointVerifyDur = 10 * time.Minute
+
+// checkpointReadAttempts is how many times the nonce and the checkpoint are read
+// before giving up when every read races with a valset update.
+const checkpointReadAttempts = 3
+
+// EthereumCheckpointSource is the part of EthereumNetwork needed to verify the valset checkpoint.
+type EthereumCheckpointSource interface {
+	GetPeggyID(ctx context.Context) (eth.Hash, error)
+	GetValsetNonce(ctx context.Context) (*big.Int, error)
+	GetValsetCheckpoint(ctx context.Context) (eth.Hash, error)
+}
+
+// CheckpointReport compares the valset checkpoint stored in the Peggy contract with
+// the one computed from the Injective valset at the same nonce.
+type CheckpointReport struct {
+	ValsetNonce uint64   `json:"valset_nonce"`
+	PeggyID     eth.Hash `json:"peggy_id"`
+	OnChain     eth.Hash `json:"on_chain"`
+	OffChain    eth.Hash `json:"off_chain"`
+	Match       bool     `json:"match"`
+}
+
+// VerifyValsetCheckpoint recomputes the checkpoint of the valset at the contract's last valset
+// nonce and compares it with the checkpoint stored in the contract.
+func VerifyValsetCheckpoint(
+	ctx context.Context,
+	injective InjectiveValsetSource,
+	ethereum EthereumCheckpointSource,
+) (*CheckpointReport, error) {
+	peggyID, err := ethereum.GetPeggyID(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get peggyID from Ethereum")
+	}
+
+	nonce, onChain, err := readValsetCheckpoint(ctx, ethereum)
+	if err != nil {
+		return nil, err
+	}
+
+	valset, err := injective.ValsetAt(ctx, nonce.Uint64())
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get valset %d from Injective", nonce.Uint64())
+	}
+
+	if valset == nil {
+		return nil, errors.Errorf("valset %d not found on Injective", nonce.Uint64())
+	}
+
+	offChain := peggy.EncodeValsetConfirm(peggyID, valset)
+
+	return &CheckpointReport{
+		ValsetNonce: nonce.Uint64(),
+		PeggyID:     peggyID,
+		OnChain:     onChain,
+		OffChain:    offChain,
+		Match:       onChain == offChain,
+	}, nil
+}
+
+// readValsetCheckpoint reads the valset nonce and checkpoint of the contract. They are read
+// in separate calls, so the nonce is read again after the checkpoint and the read is retried
+// if a valset update was relayed in between, which would otherwise show up as a mismatch.
+func readValsetCheckpoint(ctx context.Context, ethereum EthereumCheckpointSource) (*big.Int, eth.Hash, error) {
+	for i := 0; i < checkpointReadAttempts; i++ {
+		nonce, err := ethereum.GetValsetNonce(ctx)
+		if err != nil {
+			return nil, eth.Hash{}, errors.Wrap(err, "failed to get valset nonce from Ethereum")
+		}
+
+		checkpoint, err := ethereum.GetValsetCheckpoint(ctx)
+		if err != nil {
+			return nil, eth.Hash{}, errors.Wrap(err, "failed to get valset checkpoint from Ethereum")
+		}
+
+		nonceAfter, err := ethereum.GetValsetNonce(ctx)
+		if err != nil {
+			return nil, eth.Hash{}, errors.Wrap(err, "failed to get valset nonce from Ethereum")
+		}
+
+		if nonce.Cmp(nonceAfter) == 0 {
+			return nonce, checkpoint, nil
+		}
+	}
+
+	return nil, eth.Hash{}, errors.Errorf("valset nonce changed during each of %d reads of the checkpoint", checkpointReadAttempts)
+}
+
+// CheckpointVerifierLoop periodically checks that the valset checkpoint stored in the
+// Peggy contract matches the Injective valset, which would otherwise block all relaying.
+func (s *PeggyOrchestrator) CheckpointVerifierLoop(ctx context.Context) error {
+	verifier := &checkpointVerifier{
+		log: log.WithField("loop", "CheckpointVerifier"),
+	}
+
+	return loops.RunLoop(
+		ctx,
+		"CheckpointVerifier",
+		checkpointVerifyDur,
+		func() error { return verifier.run(ctx, s.injective, s.ethereum) },
+	)
+}
+
+type checkpointVerifier struct {
+	log log.Logger
+}
+
+func (v *checkpointVerifier) run(
+	ctx context.Context,
+	injective InjectiveNetwork,
+	ethereum EthereumNetwork,
+) error {
+	report, err := VerifyValsetCheckpoint(ctx, injective, ethereum)
+	if err != nil {
+		// non-fatal, the check runs again on the next iteration
+		v.log.WithError(err).Warningln("unable to verify valset checkpoint")
+		return nil
+	}
+
+	if !report.Match {
+		fields := log.Fields{
+			"valset_nonce": report.ValsetNonce,
+			"on_chain":     report.OnChain.Hex(),
+			"off_chain":    report.OffChain.Hex(),
+		}
+		v.log.WithFields(fields).WithField("severity", "critical").
+			Errorln("valset checkpoint in the Peggy contract doesn't match the Injective valset")
+		alerts.Critical("checkpoint_mismatch", "valset checkpoint in the Peggy contract doesn't match the Injective valset", fields)
+
+		return nil
+	}
+
+	v.log.WithField("valset_nonce", report.ValsetNonce).Debugln("valset checkpoint verified")
+
+	return nil
+}
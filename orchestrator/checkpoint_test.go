@@ -0,0 +1,129 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	cosmtypes "github.com/cosmos/cosmos-sdk/types"
+	eth "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/InjectiveLabs/peggo/orchestrator/ethereum/peggy"
+	"github.com/InjectiveLabs/sdk-go/chain/peggy/types"
+)
+
+func TestVerifyValsetCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	var peggyID eth.Hash
+	copy(peggyID[:], "injective-peggyid")
+
+	valset := &types.Valset{
+		Nonce: 7,
+		Members: []*types.BridgeValidator{
+			{EthereumAddress: "0x9924e52Fe6B833657335C4a71c8347fb2750742b", Power: 2147483647},
+			{EthereumAddress: "0x8D983cb9388EaC77af0474fA441C4815500Cb7BB", Power: 2147483647},
+		},
+		RewardAmount: cosmtypes.NewInt(1000),
+		RewardToken:  "0xe28b3B32B6c345A34Ff64674606124Dd5Aceca30",
+	}
+
+	checkpoint := peggy.EncodeValsetConfirm(peggyID, valset)
+
+	newEthereum := func(checkpoint eth.Hash) mockEthereum {
+		return mockEthereum{
+			getPeggyIDFn: func(context.Context) (eth.Hash, error) {
+				return peggyID, nil
+			},
+			getValsetNonceFn: func(context.Context) (*big.Int, error) {
+				return big.NewInt(7), nil
+			},
+			getValsetCheckpointFn: func(context.Context) (eth.Hash, error) {
+				return checkpoint, nil
+			},
+		}
+	}
+
+	t.Run("checkpoint matches", func(t *testing.T) {
+		t.Parallel()
+
+		inj := &mockInjective{
+			valsetAtFn: func(_ context.Context, nonce uint64) (*types.Valset, error) {
+				assert.Equal(t, uint64(7), nonce)
+				return valset, nil
+			},
+		}
+
+		report, err := VerifyValsetCheckpoint(context.Background(), inj, newEthereum(checkpoint))
+		assert.NoError(t, err)
+		assert.True(t, report.Match)
+		assert.Equal(t, checkpoint, report.OffChain)
+	})
+
+	t.Run("checkpoint mismatch", func(t *testing.T) {
+		t.Parallel()
+
+		inj := &mockInjective{
+			valsetAtFn: func(context.Context, uint64) (*types.Valset, error) {
+				return valset, nil
+			},
+		}
+
+		report, err := VerifyValsetCheckpoint(context.Background(), inj, newEthereum(eth.HexToHash("0x01")))
+		assert.NoError(t, err)
+		assert.False(t, report.Match)
+		assert.Equal(t, uint64(7), report.ValsetNonce)
+	})
+
+	t.Run("valset update relayed between the reads", func(t *testing.T) {
+		t.Parallel()
+
+		// the first checkpoint read already sees valset 7, the nonce was read before the update
+		nonces := []int64{6, 7, 7, 7}
+		ethereum := newEthereum(checkpoint)
+		ethereum.getValsetNonceFn = func(context.Context) (*big.Int, error) {
+			nonce := nonces[0]
+			nonces = nonces[1:]
+			return big.NewInt(nonce), nil
+		}
+
+		inj := &mockInjective{
+			valsetAtFn: func(_ context.Context, nonce uint64) (*types.Valset, error) {
+				assert.Equal(t, uint64(7), nonce)
+				return valset, nil
+			},
+		}
+
+		report, err := VerifyValsetCheckpoint(context.Background(), inj, ethereum)
+		assert.NoError(t, err)
+		assert.True(t, report.Match)
+		assert.Empty(t, nonces)
+	})
+
+	t.Run("valset not found on injective", func(t *testing.T) {
+		t.Parallel()
+
+		inj := &mockInjective{
+			valsetAtFn: func(context.Context, uint64) (*types.Valset, error) {
+				return nil, nil
+			},
+		}
+
+		_, err := VerifyValsetCheckpoint(context.Background(), inj, newEthereum(checkpoint))
+		assert.Error(t, err)
+	})
+
+	t.Run("failed to get checkpoint", func(t *testing.T) {
+		t.Parallel()
+
+		ethereum := newEthereum(checkpoint)
+		ethereum.getValsetCheckpointFn = func(context.Context) (eth.Hash, error) {
+			return eth.Hash{}, errors.New("fail")
+		}
+
+		_, err := VerifyValsetCheckpoint(context.Background(), &mockInjective{}, ethereum)
+		assert.Error(t, err)
+	})
+}
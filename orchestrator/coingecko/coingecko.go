@@ -11,12 +11,13 @@ import (
 	"strings"
 	"time"
 
-	"github.com/InjectiveLabs/metrics"
 	cosmtypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"github.com/shopspring/decimal"
 	log "github.com/xlab/suplog"
+
+	"github.com/InjectiveLabs/peggo/orchestrator/metrics"
 )
 
 const (
@@ -119,7 +120,13 @@ func (cp *CoingeckoPriceFeed) QueryUSDPrice(erc20Contract common.Address) (float
 		return zeroPrice, err
 	}
 
-	tokenPriceInUSD := n["usd"].(float64)
+	tokenPriceInUSD, ok := n["usd"].(float64)
+	if !ok {
+		metrics.ReportFuncError(cp.svcTags)
+		err = errors.Errorf("no USD price for %s", erc20Contract.String())
+		return zeroPrice, err
+	}
+
 	return tokenPriceInUSD, nil
 }
 
@@ -159,7 +166,8 @@ func checkCoingeckoConfig(cfg *Config) *Config {
 	return cfg
 }
 
-func (cp *CoingeckoPriceFeed) CheckFeeThreshold(erc20Contract common.Address, totalFee cosmtypes.Int, minFeeInUSD float64) bool {
+// CheckFeeThreshold tells whether the total fee, in raw units of a token with the given decimals, is worth more than minFeeInUSD.
+func (cp *CoingeckoPriceFeed) CheckFeeThreshold(erc20Contract common.Address, totalFee cosmtypes.Int, decimals uint8, minFeeInUSD float64) bool {
 	metrics.ReportFuncCall(cp.svcTags)
 	doneFn := metrics.ReportFuncTiming(cp.svcTags)
 	defer doneFn()
@@ -171,7 +179,7 @@ func (cp *CoingeckoPriceFeed) CheckFeeThreshold(erc20Contract common.Address, to
 	}
 
 	tokenPriceInUSDDec := decimal.NewFromFloat(tokenPriceInUSD)
-	totalFeeInUSDDec := decimal.NewFromBigInt(totalFee.BigInt(), -18).Mul(tokenPriceInUSDDec)
+	totalFeeInUSDDec := decimal.NewFromBigInt(totalFee.BigInt(), -int32(decimals)).Mul(tokenPriceInUSDDec)
 	minFeeInUSDDec := decimal.NewFromFloat(minFeeInUSD)
 
 	if totalFeeInUSDDec.GreaterThan(minFeeInUSDDec) {
@@ -22,12 +22,12 @@ func TestFeeThresholdTwoDecimals(t *testing.T) {
 
 	// FeeAccumulated is greater than ExpectedFee
 	totalFeeInINJ := cosmtypes.NewInt(int64(minInj) + 1).Mul(DecimalReduction)
-	isFeeLimitExceeded := coingeckoFeed.CheckFeeThreshold(injTokenContract, totalFeeInINJ, minFeeInUSD)
+	isFeeLimitExceeded := coingeckoFeed.CheckFeeThreshold(injTokenContract, totalFeeInINJ, 18, minFeeInUSD)
 	assert.True(t, isFeeLimitExceeded, "FeeAccumulated is less than ExpectedFee")
 
 	// FeeAccumulated is less than ExpectedFee
 	totalFeeInINJ = cosmtypes.NewInt(int64(minInj) - 1).Mul(DecimalReduction)
-	isFeeLimitExceeded = coingeckoFeed.CheckFeeThreshold(injTokenContract, totalFeeInINJ, minFeeInUSD)
+	isFeeLimitExceeded = coingeckoFeed.CheckFeeThreshold(injTokenContract, totalFeeInINJ, 18, minFeeInUSD)
 	assert.False(t, isFeeLimitExceeded, "FeeAccumulated is greater than ExpectedFee")
 }
 
@@ -43,11 +43,11 @@ func TestFeeThresholdNineDecimals(t *testing.T) {
 
 	// FeeAccumulated is greater than ExpectedFee
 	totalFeeInSHIB := cosmtypes.NewInt(int64(minShib) + 1).Mul(DecimalReduction)
-	isFeeLimitExceeded := coingeckoFeed.CheckFeeThreshold(shibTokenContract, totalFeeInSHIB, minFeeInUSD)
+	isFeeLimitExceeded := coingeckoFeed.CheckFeeThreshold(shibTokenContract, totalFeeInSHIB, 18, minFeeInUSD)
 	assert.True(t, isFeeLimitExceeded, "FeeAccumulated is less than ExpectedFee")
 
 	// FeeAccumulated is less than ExpectedFee
 	totalFeeInSHIB = cosmtypes.NewInt(int64(minShib) - 1).Mul(DecimalReduction)
-	isFeeLimitExceeded = coingeckoFeed.CheckFeeThreshold(shibTokenContract, totalFeeInSHIB, minFeeInUSD)
+	isFeeLimitExceeded = coingeckoFeed.CheckFeeThreshold(shibTokenContract, totalFeeInSHIB, 18, minFeeInUSD)
 	assert.False(t, isFeeLimitExceeded, "FeeAccumulated is greater than ExpectedFee")
 }
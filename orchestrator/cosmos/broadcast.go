@@ -13,7 +13,7 @@ import (
 	"github.com/InjectiveLabs/sdk-go/chain/peggy/types"
 	chainclient "github.com/InjectiveLabs/sdk-go/client/chain"
 
-	"github.com/InjectiveLabs/metrics"
+	"github.com/InjectiveLabs/peggo/orchestrator/metrics"
 
 	"github.com/InjectiveLabs/peggo/orchestrator/ethereum/keystore"
 	"github.com/InjectiveLabs/peggo/orchestrator/ethereum/peggy"
@@ -74,6 +74,13 @@ type PeggyBroadcastClient interface {
 		ctx context.Context,
 		denom string,
 	) error
+
+	// SimulateMsg returns the gas the msg uses when simulated against the latest state.
+	SimulateMsg(ctx context.Context, msg sdk.Msg) (uint64, error)
+
+	// BroadcastMsgSync broadcasts the msg and waits for CheckTx. Unlike the queued msgs
+	// of the orchestrator, a msg rejected by CheckTx is returned as an error.
+	BroadcastMsgSync(ctx context.Context, msg sdk.Msg) (*sdk.TxResponse, error)
 }
 
 func NewPeggyBroadcastClient(
@@ -594,3 +601,44 @@ func (s *peggyBroadcastClient) SendRequestBatch(
 
 	return nil
 }
+
+func (s *peggyBroadcastClient) SimulateMsg(_ context.Context, msg sdk.Msg) (uint64, error) {
+	metrics.ReportFuncCall(s.svcTags)
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+
+	res, err := s.broadcastClient.SimulateMsg(s.broadcastClient.ClientContext(), msg)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		err = errors.Wrapf(err, "simulating %s failed", sdk.MsgTypeURL(msg))
+		return 0, err
+	} else if res == nil || res.GasInfo == nil {
+		metrics.ReportFuncError(s.svcTags)
+		return 0, errors.Errorf("simulating %s returned no gas info", sdk.MsgTypeURL(msg))
+	}
+
+	return res.GasInfo.GasUsed, nil
+}
+
+func (s *peggyBroadcastClient) BroadcastMsgSync(_ context.Context, msg sdk.Msg) (*sdk.TxResponse, error) {
+	metrics.ReportFuncCall(s.svcTags)
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+
+	res, err := s.broadcastClient.SyncBroadcastMsg(msg)
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		err = errors.Wrapf(err, "broadcasting %s failed", sdk.MsgTypeURL(msg))
+		return nil, err
+	} else if res == nil || res.TxResponse == nil {
+		metrics.ReportFuncError(s.svcTags)
+		return nil, errors.Errorf("broadcasting %s returned no response", sdk.MsgTypeURL(msg))
+	}
+
+	if res.TxResponse.Code != 0 {
+		metrics.ReportFuncError(s.svcTags)
+		return res.TxResponse, errors.Errorf("tx %s failed with code %d: %s", res.TxResponse.TxHash, res.TxResponse.Code, res.TxResponse.RawLog)
+	}
+
+	return res.TxResponse, nil
+}
@@ -2,6 +2,7 @@ package cosmos
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
@@ -27,6 +28,8 @@ type Network struct {
 	tmclient.TendermintClient
 	PeggyQueryClient
 	PeggyBroadcastClient
+
+	grpcConn *grpc.ClientConn
 }
 
 func NewNetwork(
@@ -71,6 +74,7 @@ func NewNetwork(
 		TendermintClient:     tmclient.NewRPCClient(tendermintRPC),
 		PeggyQueryClient:     NewPeggyQueryClient(peggyQuerier),
 		PeggyBroadcastClient: NewPeggyBroadcastClient(peggyQuerier, daemonClient, signerFn, personalSignerFn),
+		grpcConn:             grpcConn,
 	}
 
 	log.WithFields(log.Fields{
@@ -117,6 +121,26 @@ func (n *Network) UnbatchedTokenFees(ctx context.Context) ([]*peggy.BatchFees, e
 	return n.PeggyQueryClient.UnbatchedTokensWithFees(ctx)
 }
 
+// TransferCreatedAt returns the time of the block the withdrawal with the given id was sent in.
+func (n *Network) TransferCreatedAt(ctx context.Context, id uint64) (time.Time, error) {
+	// typed event attributes are JSON-encoded, so the id is quoted
+	query := fmt.Sprintf("injective.peggy.v1.EventSendToEth.outgoing_tx_id='\"%d\"'", id)
+
+	txs, err := n.TendermintClient.SearchTxs(ctx, query, 1)
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "failed to search for the SendToEth tx")
+	} else if len(txs) == 0 {
+		return time.Time{}, ErrNotFound
+	}
+
+	block, err := n.TendermintClient.GetBlock(ctx, txs[0].Height)
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "failed to get the block of the SendToEth tx")
+	}
+
+	return block.Block.Time, nil
+}
+
 func (n *Network) SendRequestBatch(ctx context.Context, denom string) error {
 	return n.PeggyBroadcastClient.SendRequestBatch(ctx, denom)
 }
@@ -167,6 +191,17 @@ func (n *Network) SendBatchConfirm(
 	return n.PeggyBroadcastClient.SendBatchConfirm(ctx, ethFrom, peggyID, batch)
 }
 
+// CheckGRPC returns an error if the GRPC connection to Injective is down. An idle
+// connection is fine, it reconnects on the next call.
+func (n *Network) CheckGRPC(_ context.Context) error {
+	switch state := n.grpcConn.GetState(); state {
+	case connectivity.Ready, connectivity.Idle:
+		return nil
+	default:
+		return errors.Errorf("GRPC connection is %s", state.String())
+	}
+}
+
 // waitForService awaits an active ClientConn to a GRPC service.
 func waitForService(ctx context.Context, clientconn *grpc.ClientConn) {
 	for {
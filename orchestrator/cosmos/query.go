@@ -7,7 +7,7 @@ import (
 	ethcmn "github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 
-	"github.com/InjectiveLabs/metrics"
+	"github.com/InjectiveLabs/peggo/orchestrator/metrics"
 	"github.com/InjectiveLabs/sdk-go/chain/peggy/types"
 )
 
@@ -20,9 +20,16 @@ type PeggyQueryClient interface {
 	OldestUnsignedTransactionBatch(ctx context.Context, valAccountAddress sdk.AccAddress) (*types.OutgoingTxBatch, error)
 	LatestTransactionBatches(ctx context.Context) ([]*types.OutgoingTxBatch, error)
 	UnbatchedTokensWithFees(ctx context.Context) ([]*types.BatchFees, error)
+	UnbatchedTransfers(ctx context.Context) ([]*types.OutgoingTransferTx, error)
+	ERC20ToDenoms(ctx context.Context) ([]*types.ERC20ToDenom, error)
+	ERC20ToDenom(ctx context.Context, tokenContract ethcmn.Address) (denom string, cosmosOriginated bool, err error)
+	DenomToERC20(ctx context.Context, denom string) (ethcmn.Address, error)
+	LastObservedEventNonce(ctx context.Context) (uint64, error)
 
 	TransactionBatchSignatures(ctx context.Context, nonce uint64, tokenContract ethcmn.Address) ([]*types.MsgConfirmBatch, error)
 	LastClaimEventByAddr(ctx context.Context, validatorAccountAddress sdk.AccAddress) (*types.LastClaimEvent, error)
+	DelegateKeysByEthAddress(ctx context.Context, ethAddress ethcmn.Address) (*types.QueryDelegateKeysByEthAddressResponse, error)
+	PendingSendToEth(ctx context.Context, senderAddress sdk.AccAddress) (*types.QueryPendingSendToEthResponse, error)
 
 	PeggyParams(ctx context.Context) (*types.Params, error)
 }
@@ -195,6 +202,139 @@ func (s *peggyQueryClient) UnbatchedTokensWithFees(ctx context.Context) ([]*type
 	return daemonResp.BatchFees, nil
 }
 
+// UnbatchedTransfers returns the withdrawals waiting in the pool to be included in a batch.
+// There's no targeted query for the whole pool, so this reads the module state dump
+// and callers are expected to refresh it sparingly.
+func (s *peggyQueryClient) UnbatchedTransfers(ctx context.Context) ([]*types.OutgoingTransferTx, error) {
+	metrics.ReportFuncCall(s.svcTags)
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+
+	daemonResp, err := s.daemonQueryClient.PeggyModuleState(ctx, &types.QueryModuleStateRequest{})
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		err = errors.Wrap(err, "failed to query PeggyModuleState from daemon")
+		return nil, err
+	} else if daemonResp == nil || daemonResp.State == nil {
+		metrics.ReportFuncError(s.svcTags)
+		return nil, ErrNotFound
+	}
+
+	return daemonResp.State.UnbatchedTransfers, nil
+}
+
+// ERC20ToDenoms returns the ERC20 contracts of Cosmos-originated tokens along with their denoms.
+// Only tokens with queued withdrawals or outgoing batches are looked up, since nothing else
+// needs to be relayed.
+func (s *peggyQueryClient) ERC20ToDenoms(ctx context.Context) ([]*types.ERC20ToDenom, error) {
+	fees, err := s.UnbatchedTokensWithFees(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	batches, err := s.LatestTransactionBatches(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		tokens []ethcmn.Address
+		seen   = make(map[ethcmn.Address]struct{})
+	)
+
+	addToken := func(token string) {
+		tokenAddr := ethcmn.HexToAddress(token)
+		if _, ok := seen[tokenAddr]; !ok {
+			seen[tokenAddr] = struct{}{}
+			tokens = append(tokens, tokenAddr)
+		}
+	}
+
+	for _, fee := range fees {
+		addToken(fee.Token)
+	}
+
+	for _, batch := range batches {
+		addToken(batch.TokenContract)
+	}
+
+	var mappings []*types.ERC20ToDenom
+	for _, tokenAddr := range tokens {
+		denom, cosmosOriginated, err := s.ERC20ToDenom(ctx, tokenAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		if cosmosOriginated {
+			mappings = append(mappings, &types.ERC20ToDenom{Erc20: tokenAddr.Hex(), Denom: denom})
+		}
+	}
+
+	return mappings, nil
+}
+
+// ERC20ToDenom returns the Injective denom of the token and whether it's Cosmos-originated.
+func (s *peggyQueryClient) ERC20ToDenom(ctx context.Context, tokenContract ethcmn.Address) (string, bool, error) {
+	metrics.ReportFuncCall(s.svcTags)
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+
+	daemonResp, err := s.daemonQueryClient.ERC20ToDenom(ctx, &types.QueryERC20ToDenomRequest{
+		Erc20: tokenContract.Hex(),
+	})
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		err = errors.Wrap(err, "failed to query ERC20ToDenom from daemon")
+		return "", false, err
+	} else if daemonResp == nil {
+		metrics.ReportFuncError(s.svcTags)
+		return "", false, ErrNotFound
+	}
+
+	return daemonResp.Denom, daemonResp.CosmosOriginated, nil
+}
+
+// DenomToERC20 returns the ERC20 contract of the bridged denom.
+func (s *peggyQueryClient) DenomToERC20(ctx context.Context, denom string) (ethcmn.Address, error) {
+	metrics.ReportFuncCall(s.svcTags)
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+
+	daemonResp, err := s.daemonQueryClient.DenomToERC20(ctx, &types.QueryDenomToERC20Request{
+		Denom: denom,
+	})
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		err = errors.Wrap(err, "failed to query DenomToERC20 from daemon")
+		return ethcmn.Address{}, err
+	} else if daemonResp == nil || !ethcmn.IsHexAddress(daemonResp.Erc20) {
+		metrics.ReportFuncError(s.svcTags)
+		return ethcmn.Address{}, ErrNotFound
+	}
+
+	return ethcmn.HexToAddress(daemonResp.Erc20), nil
+}
+
+// LastObservedEventNonce returns the nonce of the last Ethereum event attested by enough validators.
+// There's no targeted query for it, so it's read from the module state dump.
+func (s *peggyQueryClient) LastObservedEventNonce(ctx context.Context) (uint64, error) {
+	metrics.ReportFuncCall(s.svcTags)
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+
+	daemonResp, err := s.daemonQueryClient.PeggyModuleState(ctx, &types.QueryModuleStateRequest{})
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		err = errors.Wrap(err, "failed to query PeggyModuleState from daemon")
+		return 0, err
+	} else if daemonResp == nil || daemonResp.State == nil {
+		metrics.ReportFuncError(s.svcTags)
+		return 0, ErrNotFound
+	}
+
+	return daemonResp.State.LastObservedNonce, nil
+}
+
 func (s *peggyQueryClient) TransactionBatchSignatures(ctx context.Context, nonce uint64, tokenContract ethcmn.Address) ([]*types.MsgConfirmBatch, error) {
 	metrics.ReportFuncCall(s.svcTags)
 	doneFn := metrics.ReportFuncTiming(s.svcTags)
@@ -253,3 +393,43 @@ func (s *peggyQueryClient) PeggyParams(ctx context.Context) (*types.Params, erro
 
 	return &daemonResp.Params, nil
 }
+
+func (s *peggyQueryClient) DelegateKeysByEthAddress(ctx context.Context, ethAddress ethcmn.Address) (*types.QueryDelegateKeysByEthAddressResponse, error) {
+	metrics.ReportFuncCall(s.svcTags)
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+
+	daemonResp, err := s.daemonQueryClient.GetDelegateKeyByEth(ctx, &types.QueryDelegateKeysByEthAddress{
+		EthAddress: ethAddress.Hex(),
+	})
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		err = errors.Wrap(err, "failed to query GetDelegateKeyByEth from daemon")
+		return nil, err
+	} else if daemonResp == nil {
+		metrics.ReportFuncError(s.svcTags)
+		return nil, ErrNotFound
+	}
+
+	return daemonResp, nil
+}
+
+func (s *peggyQueryClient) PendingSendToEth(ctx context.Context, senderAddress sdk.AccAddress) (*types.QueryPendingSendToEthResponse, error) {
+	metrics.ReportFuncCall(s.svcTags)
+	doneFn := metrics.ReportFuncTiming(s.svcTags)
+	defer doneFn()
+
+	daemonResp, err := s.daemonQueryClient.GetPendingSendToEth(ctx, &types.QueryPendingSendToEth{
+		SenderAddress: senderAddress.String(),
+	})
+	if err != nil {
+		metrics.ReportFuncError(s.svcTags)
+		err = errors.Wrap(err, "failed to query GetPendingSendToEth from daemon")
+		return nil, err
+	} else if daemonResp == nil {
+		metrics.ReportFuncError(s.svcTags)
+		return nil, ErrNotFound
+	}
+
+	return daemonResp, nil
+}
@@ -4,7 +4,7 @@ import (
 	"context"
 	"strings"
 
-	"github.com/InjectiveLabs/metrics"
+	"github.com/InjectiveLabs/peggo/orchestrator/metrics"
 
 	log "github.com/xlab/suplog"
 
@@ -19,6 +19,7 @@ type TendermintClient interface {
 	GetLatestBlockHeight(ctx context.Context) (int64, error)
 	GetTxs(ctx context.Context, block *tmctypes.ResultBlock) ([]*ctypes.ResultTx, error)
 	GetValidatorSet(ctx context.Context, height int64) (*tmctypes.ResultValidators, error)
+	SearchTxs(ctx context.Context, query string, limit int) ([]*ctypes.ResultTx, error)
 }
 
 type tmClient struct {
@@ -97,3 +98,20 @@ func (c *tmClient) GetValidatorSet(ctx context.Context, height int64) (*tmctypes
 
 	return c.rpcClient.Validators(ctx, &height, nil, nil)
 }
+
+// SearchTxs returns the oldest transactions matching the event query, at most limit of them.
+// The node must have tx indexing enabled.
+func (c *tmClient) SearchTxs(ctx context.Context, query string, limit int) ([]*ctypes.ResultTx, error) {
+	metrics.ReportFuncCall(c.svcTags)
+	doneFn := metrics.ReportFuncTiming(c.svcTags)
+	defer doneFn()
+
+	page := 1
+	result, err := c.rpcClient.TxSearch(ctx, query, false, &page, &limit, "asc")
+	if err != nil {
+		metrics.ReportFuncError(c.svcTags)
+		return nil, err
+	}
+
+	return result.Txs, nil
+}
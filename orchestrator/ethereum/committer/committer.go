@@ -3,34 +3,56 @@ package committer
 import (
 	"context"
 	"math/big"
-	"strings"
 	"time"
 
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"github.com/shopspring/decimal"
 
 	"github.com/InjectiveLabs/peggo/orchestrator/ethereum/provider"
+	"github.com/InjectiveLabs/peggo/orchestrator/ethereum/util"
 )
 
 // EVMCommitter defines an interface for submitting transactions
 // into Ethereum, Matic, and other EVM-compatible networks.
 type EVMCommitter interface {
 	FromAddress() common.Address
+	// SenderAddresses returns every account transactions are sent from, FromAddress first.
+	SenderAddresses() []common.Address
 	Provider() provider.EVMProvider
+	// GasPrice returns the gas price SendTx would currently pay for a tx with the given options.
+	GasPrice(ctx context.Context, txOpts ...TxOption) (*big.Int, error)
 	SendTx(
 		ctx context.Context,
 		recipient common.Address,
 		txData []byte,
+		txOpts ...TxOption,
 	) (txHash common.Hash, err error)
 }
 
+// Account is a sender account the committer can submit transactions from.
+type Account struct {
+	Address common.Address
+	Signer  bind.SignerFn
+}
+
 type EVMCommitterOption func(o *options) error
 
 type options struct {
 	GasPrice   decimal.Decimal
 	GasLimit   uint64
 	RPCTimeout time.Duration
+
+	SenderPool       []Account
+	MinSenderBalance *big.Int
+
+	NonceJournal   util.NonceJournal
+	StuckTxTimeout time.Duration
+
+	GasPricers           map[TxKind]GasPricerConfig
+	UrgencyWindow        uint64
+	UrgencyMaxMultiplier float64
 }
 
 func defaultOptions() *options {
@@ -39,6 +61,9 @@ func defaultOptions() *options {
 		GasPrice:   v.Shift(9), // 20 gwei
 		GasLimit:   1000000,
 		RPCTimeout: 10 * time.Second,
+
+		MinSenderBalance: new(big.Int),
+		StuckTxTimeout:   30 * time.Minute,
 	}
 }
 
@@ -66,31 +91,15 @@ func OptionGasPriceFromString(str string) EVMCommitterOption {
 	}
 }
 
-func ParseMaxGasPrice(maxGasPriceStr string) int64 {
-	maxGasPriceStr = strings.TrimSpace(maxGasPriceStr)
-	maxGasPriceStr = strings.ToLower(maxGasPriceStr)
-
-	// If the denom is gwei, convert to wei
-	unit := "gwei"
-	isGwei := false
-	if strings.HasSuffix(maxGasPriceStr, unit) {
-		maxGasPriceStr = strings.TrimSuffix(maxGasPriceStr, unit)
-		isGwei = true
-	}
-
-	// if denom is not present, consider it as wei
-	maxGasPriceStr = strings.TrimSpace(maxGasPriceStr)
-	maxGasPrice, err := decimal.NewFromString(maxGasPriceStr)
+// ParseMaxGasPrice parses the max gas price, in wei or gwei (e.g. 500gwei).
+func ParseMaxGasPrice(maxGasPriceStr string) (*big.Int, error) {
+	maxGasPrice, err := ParseGasPrice(maxGasPriceStr)
 	if err != nil {
-		err = errors.Wrap(err, "unable to parse max gas price. max_gas_price should be in gwei")
-		panic(err)
-	}
-
-	if isGwei {
-		maxGasPrice = maxGasPrice.Shift(9) // Gwei to wei
+		err = errors.Wrap(err, "unable to parse max gas price")
+		return nil, err
 	}
 
-	return maxGasPrice.IntPart()
+	return maxGasPrice, nil
 }
 
 func OptionGasPriceFromDecimal(gasPrice decimal.Decimal) EVMCommitterOption {
@@ -120,3 +129,75 @@ func TxBroadcastTimeout(dur time.Duration) EVMCommitterOption {
 		return nil
 	}
 }
+
+// OptionSenderPool adds extra sender accounts to the committer. Transactions are
+// dispatched to whichever account has no transactions in flight, so a stuck tx
+// on one account doesn't block submissions from the others.
+func OptionSenderPool(accounts ...Account) EVMCommitterOption {
+	return func(o *options) error {
+		for _, acc := range accounts {
+			if acc.Signer == nil {
+				return errors.Errorf("no signer provided for sender account %s", acc.Address.Hex())
+			}
+		}
+
+		o.SenderPool = append(o.SenderPool, accounts...)
+		return nil
+	}
+}
+
+// OptionMinSenderBalance sets the balance (in wei) below which a sender account is not used.
+func OptionMinSenderBalance(minBalance *big.Int) EVMCommitterOption {
+	return func(o *options) error {
+		if minBalance == nil || minBalance.Sign() < 0 {
+			return errors.New("min sender balance must be non-negative")
+		}
+
+		o.MinSenderBalance = minBalance
+		return nil
+	}
+}
+
+// OptionNonceJournal sets a journal to persist used nonces, so that pending txs
+// and nonce gaps can be recovered after a restart.
+func OptionNonceJournal(journal util.NonceJournal) EVMCommitterOption {
+	return func(o *options) error {
+		o.NonceJournal = journal
+		return nil
+	}
+}
+
+// OptionStuckTxTimeout sets how long a sent tx may stay unmined before it's replaced during nonce recovery.
+func OptionStuckTxTimeout(dur time.Duration) EVMCommitterOption {
+	return func(o *options) error {
+		o.StuckTxTimeout = dur
+		return nil
+	}
+}
+
+// OptionGasPricer sets the gas price strategy for a tx kind. Kinds without
+// a strategy use the one of TxKindDefault, which defaults to the suggested gas price.
+func OptionGasPricer(kind TxKind, cfg GasPricerConfig) EVMCommitterOption {
+	return func(o *options) error {
+		if o.GasPricers == nil {
+			o.GasPricers = make(map[TxKind]GasPricerConfig)
+		}
+
+		o.GasPricers[kind] = cfg
+		return nil
+	}
+}
+
+// OptionGasPriceUrgency makes txs with a deadline pay up to maxMultiplier times
+// the usual gas price within window blocks before the deadline.
+func OptionGasPriceUrgency(window uint64, maxMultiplier float64) EVMCommitterOption {
+	return func(o *options) error {
+		if maxMultiplier < 1 {
+			return errors.New("urgency gas price multiplier must be at least 1")
+		}
+
+		o.UrgencyWindow = window
+		o.UrgencyMaxMultiplier = maxMultiplier
+		return nil
+	}
+}
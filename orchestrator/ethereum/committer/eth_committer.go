@@ -2,8 +2,11 @@ package committer
 
 import (
 	"context"
+	"math"
 	"math/big"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
@@ -11,11 +14,15 @@ import (
 	"github.com/pkg/errors"
 	log "github.com/xlab/suplog"
 
-	"github.com/InjectiveLabs/metrics"
+	"github.com/InjectiveLabs/peggo/orchestrator/alerts"
 	"github.com/InjectiveLabs/peggo/orchestrator/ethereum/provider"
 	"github.com/InjectiveLabs/peggo/orchestrator/ethereum/util"
+	"github.com/InjectiveLabs/peggo/orchestrator/metrics"
 )
 
+// senderPollInterval is how often the committer checks for a free sender account when all are busy.
+const senderPollInterval = time.Second
+
 // NewEthCommitter returns an instance of EVMCommitter, which
 // can be used to submit txns into Ethereum, Matic, and other EVM-compatible networks.
 func NewEthCommitter(
@@ -26,6 +33,11 @@ func NewEthCommitter(
 	evmProvider provider.EVMProviderWithRet,
 	committerOpts ...EVMCommitterOption,
 ) (EVMCommitter, error) {
+	maxGasPrice, err := ParseMaxGasPrice(ethMaxGasPrice)
+	if err != nil {
+		return nil, err
+	}
+
 	committer := &ethCommitter{
 		committerOpts: defaultOptions(),
 		svcTags: metrics.Tags{
@@ -33,21 +45,63 @@ func NewEthCommitter(
 		},
 
 		ethGasPriceAdjustment: ethGasPriceAdjustment,
-		ethMaxGasPrice:        ParseMaxGasPrice(ethMaxGasPrice),
+		ethMaxGasPrice:        maxGasPrice,
 		fromAddress:           fromAddress,
-		fromSigner:            fromSigner,
 		evmProvider:           evmProvider,
 		nonceCache:            util.NewNonceCache(),
+		sendersByAddr:         make(map[common.Address]*senderAccount),
 	}
 
 	if err := applyOptions(committer.committerOpts, committerOpts...); err != nil {
 		return nil, err
 	}
 
-	committer.nonceCache.Sync(fromAddress, func() (uint64, error) {
-		nonce, err := evmProvider.PendingNonceAt(context.TODO(), fromAddress)
-		return nonce, err
-	})
+	if err := committer.initGasPricers(); err != nil {
+		return nil, err
+	}
+
+	committer.nonceJournal = committer.committerOpts.NonceJournal
+	if committer.nonceJournal == nil {
+		committer.nonceJournal, _ = util.NewNonceJournal("")
+	}
+
+	accounts := append([]Account{{Address: fromAddress, Signer: fromSigner}}, committer.committerOpts.SenderPool...)
+	for _, acc := range accounts {
+		if _, ok := committer.sendersByAddr[acc.Address]; ok {
+			continue
+		}
+
+		sender := &senderAccount{
+			address: acc.Address,
+			signer:  acc.Signer,
+			svcTags: metrics.Tags{
+				"module":  "eth_committer",
+				"account": acc.Address.Hex(),
+			},
+		}
+
+		committer.sendersByAddr[acc.Address] = sender
+		committer.senders = append(committer.senders, sender)
+
+		if err := committer.recoverNonces(context.Background(), sender); err != nil {
+			log.WithError(err).WithField("account", acc.Address.Hex()).Warningln("failed to recover nonces, syncing with pending nonce")
+
+			address := acc.Address
+			committer.nonceCache.Sync(address, func() (uint64, error) {
+				nonce, err := evmProvider.PendingNonceAt(context.TODO(), address)
+				return nonce, err
+			})
+		}
+	}
+
+	if len(committer.senders) > 1 {
+		addrs := make([]string, 0, len(committer.senders))
+		for _, sender := range committer.senders {
+			addrs = append(addrs, sender.address.Hex())
+		}
+
+		log.WithField("accounts", addrs).Infoln("using a pool of Ethereum sender accounts")
+	}
 
 	return committer, nil
 }
@@ -56,78 +110,301 @@ type ethCommitter struct {
 	committerOpts *options
 
 	fromAddress common.Address
-	fromSigner  bind.SignerFn
+
+	senders       []*senderAccount
+	sendersByAddr map[common.Address]*senderAccount
+	sendersMux    sync.Mutex
 
 	ethGasPriceAdjustment float64
-	ethMaxGasPrice        int64
+	ethMaxGasPrice        *big.Int
+	gasPricers            map[TxKind]GasPricer
 	evmProvider           provider.EVMProviderWithRet
 	nonceCache            util.NonceCache
+	nonceJournal          util.NonceJournal
 
 	svcTags metrics.Tags
 }
 
+// senderAccount is a pool member. An account is busy while a tx is being
+// signed and broadcast from it, so its nonce is never used concurrently.
+type senderAccount struct {
+	address common.Address
+	signer  bind.SignerFn
+	svcTags metrics.Tags
+	busy    bool
+
+	lastRecovery time.Time
+}
+
+func (e *ethCommitter) initGasPricers() error {
+	e.gasPricers = make(map[TxKind]GasPricer)
+	e.gasPricers[TxKindDefault] = NewSuggestedGasPricer(e.evmProvider, e.ethGasPriceAdjustment)
+
+	for kind, cfg := range e.committerOpts.GasPricers {
+		pricer, err := NewGasPricer(cfg, e.evmProvider, e.ethGasPriceAdjustment)
+		if err != nil {
+			return errors.Wrapf(err, "failed to init gas pricer for %s txs", kind)
+		}
+
+		e.gasPricers[kind] = pricer
+	}
+
+	if e.committerOpts.UrgencyWindow > 0 {
+		currentHeight := func(ctx context.Context) (uint64, error) {
+			header, err := e.evmProvider.HeaderByNumber(ctx, nil)
+			if err != nil {
+				return 0, err
+			}
+
+			return header.Number.Uint64(), nil
+		}
+
+		for kind, pricer := range e.gasPricers {
+			e.gasPricers[kind] = NewUrgencyGasPricer(
+				pricer,
+				currentHeight,
+				e.committerOpts.UrgencyWindow,
+				e.committerOpts.UrgencyMaxMultiplier,
+				e.ethMaxGasPrice,
+			)
+		}
+	}
+
+	return nil
+}
+
+func (e *ethCommitter) GasPrice(ctx context.Context, txOpts ...TxOption) (*big.Int, error) {
+	req := GasPriceRequest{Kind: TxKindDefault}
+	for _, opt := range txOpts {
+		opt(&req)
+	}
+
+	rpcCtx, cancelFn := context.WithTimeout(ctx, e.committerOpts.RPCTimeout)
+	defer cancelFn()
+
+	return e.gasPrice(rpcCtx, req)
+}
+
+func (e *ethCommitter) gasPrice(ctx context.Context, req GasPriceRequest) (*big.Int, error) {
+	pricer, ok := e.gasPricers[req.Kind]
+	if !ok {
+		pricer = e.gasPricers[TxKindDefault]
+	}
+
+	gasPrice, err := pricer.GasPrice(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	capped, err := capGasPrice(gasPrice, e.ethMaxGasPrice)
+	if err != nil {
+		alerts.Warning("gas_price_above_max", "Ethereum gas price is above the max gas price, not relaying", map[string]interface{}{
+			"gas_price":     gasPrice.String(),
+			"max_gas_price": e.ethMaxGasPrice.String(),
+			"tx_kind":       req.Kind,
+		})
+		return nil, err
+	}
+
+	return capped, nil
+}
+
 func (e *ethCommitter) FromAddress() common.Address {
 	return e.fromAddress
 }
 
+func (e *ethCommitter) SenderAddresses() []common.Address {
+	e.sendersMux.Lock()
+	defer e.sendersMux.Unlock()
+
+	addrs := make([]common.Address, 0, len(e.senders))
+	for _, sender := range e.senders {
+		addrs = append(addrs, sender.address)
+	}
+
+	return addrs
+}
+
 func (e *ethCommitter) Provider() provider.EVMProvider {
 	return e.evmProvider
 }
 
+// acquireSender picks a free sender account that has enough balance, preferring
+// accounts without transactions in flight. It blocks until an account is free.
+func (e *ethCommitter) acquireSender(ctx context.Context) (*senderAccount, error) {
+	for {
+		var (
+			best           *senderAccount
+			bestInFlight   = uint64(math.MaxUint64)
+			lowBalance     int
+			freeCandidates = e.freeSenders()
+		)
+
+		for _, sender := range freeCandidates {
+			if !e.hasSufficientBalance(ctx, sender) {
+				lowBalance++
+				continue
+			}
+
+			inFlight := e.inFlightTxs(ctx, sender)
+			if inFlight < bestInFlight {
+				best, bestInFlight = sender, inFlight
+			}
+
+			if inFlight == 0 {
+				break
+			}
+		}
+
+		if best != nil && e.tryMarkBusy(best) {
+			return best, nil
+		}
+
+		if len(freeCandidates) > 0 && lowBalance == len(freeCandidates) {
+			return nil, errors.New("no sender account has sufficient balance")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, errors.Wrap(ctx.Err(), "no free sender account")
+		case <-time.After(senderPollInterval):
+		}
+	}
+}
+
+func (e *ethCommitter) freeSenders() []*senderAccount {
+	e.sendersMux.Lock()
+	defer e.sendersMux.Unlock()
+
+	free := make([]*senderAccount, 0, len(e.senders))
+	for _, sender := range e.senders {
+		if !sender.busy {
+			free = append(free, sender)
+		}
+	}
+
+	return free
+}
+
+func (e *ethCommitter) tryMarkBusy(sender *senderAccount) bool {
+	e.sendersMux.Lock()
+	defer e.sendersMux.Unlock()
+
+	if sender.busy {
+		return false
+	}
+
+	sender.busy = true
+	return true
+}
+
+func (e *ethCommitter) releaseSender(sender *senderAccount) {
+	e.sendersMux.Lock()
+	defer e.sendersMux.Unlock()
+
+	sender.busy = false
+}
+
+func (e *ethCommitter) hasSufficientBalance(ctx context.Context, sender *senderAccount) bool {
+	metrics.ReportFuncCall(sender.svcTags)
+
+	ctx, cancelFn := context.WithTimeout(ctx, e.committerOpts.RPCTimeout)
+	defer cancelFn()
+
+	balance, err := e.evmProvider.BalanceAt(ctx, sender.address, nil)
+	if err != nil {
+		metrics.ReportFuncError(sender.svcTags)
+		log.WithError(err).WithField("account", sender.address.Hex()).Warningln("failed to get sender account balance")
+
+		// let the tx itself fail if the account is actually broke
+		return true
+	}
+
+	balanceETH, _ := new(big.Float).Quo(new(big.Float).SetInt(balance), big.NewFloat(1e18)).Float64()
+	metrics.SetSenderBalance(sender.address.Hex(), balanceETH)
+
+	if balance.Cmp(e.committerOpts.MinSenderBalance) < 0 {
+		log.WithFields(log.Fields{
+			"account":     sender.address.Hex(),
+			"balance":     balance.String(),
+			"min_balance": e.committerOpts.MinSenderBalance.String(),
+		}).Warningln("sender account balance is too low, skipping it")
+
+		return false
+	}
+
+	return true
+}
+
+// inFlightTxs returns the number of txs sent from the account that are not yet mined.
+func (e *ethCommitter) inFlightTxs(ctx context.Context, sender *senderAccount) uint64 {
+	ctx, cancelFn := context.WithTimeout(ctx, e.committerOpts.RPCTimeout)
+	defer cancelFn()
+
+	minedNonce, err := e.evmProvider.NonceAt(ctx, sender.address, nil)
+	if err != nil {
+		log.WithError(err).WithField("account", sender.address.Hex()).Warningln("failed to get sender account nonce")
+		return 0
+	}
+
+	nextNonce, _ := e.nonceCache.Get(sender.address)
+	if nextNonce <= int64(minedNonce) {
+		return 0
+	}
+
+	return uint64(nextNonce) - minedNonce
+}
+
 func (e *ethCommitter) SendTx(
 	ctx context.Context,
 	recipient common.Address,
 	txData []byte,
+	txOpts ...TxOption,
 ) (txHash common.Hash, err error) {
 	metrics.ReportFuncCall(e.svcTags)
 	doneFn := metrics.ReportFuncTiming(e.svcTags)
 	defer doneFn()
 
+	sender, err := e.acquireSender(ctx)
+	if err != nil {
+		metrics.ReportFuncError(e.svcTags)
+		return common.Hash{}, err
+	}
+	defer e.releaseSender(sender)
+
+	metrics.ReportFuncCall(sender.svcTags)
+
 	opts := &bind.TransactOpts{
-		From:   e.fromAddress,
-		Signer: e.fromSigner,
+		From:   sender.address,
+		Signer: sender.signer,
 
 		GasPrice: e.committerOpts.GasPrice.BigInt(),
 		GasLimit: e.committerOpts.GasLimit,
 		Context:  ctx, // with RPC timeout
 	}
 
-	// Figure out the gas price values
-	suggestedGasPrice, err := e.evmProvider.SuggestGasPrice(opts.Context)
+	req := GasPriceRequest{Kind: TxKindDefault}
+	for _, opt := range txOpts {
+		opt(&req)
+	}
+
+	gasPrice, err := e.gasPrice(opts.Context, req)
 	if err != nil {
 		metrics.ReportFuncError(e.svcTags)
-		return common.Hash{}, errors.Errorf("failed to suggest gas price: %v", err)
+		return common.Hash{}, errors.Wrapf(err, "failed to price %s tx", req.Kind)
 	}
 
-	// Suggested gas price is not accurate. Increment by multiplying with gasprice adjustment factor
-	incrementedPrice := big.NewFloat(0).Mul(new(big.Float).SetInt(suggestedGasPrice), big.NewFloat(e.ethGasPriceAdjustment))
-
-	// set gasprice to incremented gas price.
-	gasPrice := new(big.Int)
-	incrementedPrice.Int(gasPrice)
-
 	opts.GasPrice = gasPrice
 
-	//The gas price should be less than max gas price
-	maxGasPrice := big.NewInt(int64(e.ethMaxGasPrice))
-	if opts.GasPrice.Cmp(maxGasPrice) > 0 {
-		return common.Hash{}, errors.Errorf("Suggested gas price %v is greater than max gas price %v", opts.GasPrice.Int64(), maxGasPrice.Int64())
-	}
-
-	resyncNonces := func(from common.Address) {
-		e.nonceCache.Sync(from, func() (uint64, error) {
-			nonce, err := e.evmProvider.PendingNonceAt(context.TODO(), from)
-			if err != nil {
-				log.WithError(err).Warningln("unable to acquire nonce")
+	if err := e.nonceCache.Serialize(sender.address, func() (err error) {
+		if time.Since(sender.lastRecovery) > nonceRecoveryInterval {
+			if err := e.recoverNonces(ctx, sender); err != nil {
+				log.WithError(err).WithField("account", sender.address.Hex()).Warningln("failed to recover nonces")
 			}
+		}
 
-			return nonce, err
-		})
-	}
-
-	if err := e.nonceCache.Serialize(e.fromAddress, func() (err error) {
-		nonce, _ := e.nonceCache.Get(e.fromAddress)
-		var resyncUsed bool
+		nonce, _ := e.nonceCache.Get(sender.address)
+		var recoveryUsed bool
 
 		for {
 			opts.Nonce = big.NewInt(nonce)
@@ -146,49 +423,55 @@ func (e *ethCommitter) SendTx(
 			if err == nil {
 				// override with a real hash from node resp
 				txHash = txHashRet
-				e.nonceCache.Incr(e.fromAddress)
+				e.nonceCache.Incr(sender.address)
+				e.recordNonce(sender, opts.Nonce.Uint64(), txHash, opts.GasPrice)
 				return nil
 			} else {
 				log.WithFields(log.Fields{
 					"tx_hash": txHash.Hex(),
+					"account": sender.address.Hex(),
 				}).WithError(err).Warningln("failed to send tx")
 			}
 
 			switch {
 			case strings.Contains(err.Error(), "invalid sender"):
+				// the tx never made it into the pool, so the nonce is still free
 				err := errors.New("failed to sign transaction")
-				e.nonceCache.Incr(e.fromAddress)
 				return err
 			case strings.Contains(err.Error(), "nonce too low"),
 				strings.Contains(err.Error(), "nonce too high"),
 				strings.Contains(err.Error(), "the tx doesn't have the correct nonce"):
 
-				if resyncUsed {
-					log.Errorf("nonces synced, but still wrong nonce for %s: %d", e.fromAddress, nonce)
+				if recoveryUsed {
+					log.Errorf("nonces recovered, but still wrong nonce for %s: %d", sender.address, nonce)
 					err = errors.Wrapf(err, "nonce %d mismatch", nonce)
 					return err
 				}
 
-				resyncNonces(e.fromAddress)
+				// reconcile with the chain and fill any gaps, instead of blindly skipping nonces
+				if err := e.recoverNonces(ctx, sender); err != nil {
+					err = errors.Wrap(err, "failed to recover nonces")
+					return err
+				}
 
-				resyncUsed = true
+				recoveryUsed = true
 				// try again with updated nonce
-				nonce, _ = e.nonceCache.Get(e.fromAddress)
-				opts.Nonce = big.NewInt(nonce)
+				nonce, _ = e.nonceCache.Get(sender.address)
 
 				continue
 
-			default:
-				if strings.Contains(err.Error(), "known transaction") {
-					// skip one nonce step, try to send again
-					nonce := e.nonceCache.Incr(e.fromAddress)
-					opts.Nonce = big.NewInt(nonce)
-					continue
-				}
+			case strings.Contains(err.Error(), "known transaction"),
+				strings.Contains(err.Error(), "already known"):
+				// this exact tx is already in the pool, so it has been sent
+				e.nonceCache.Incr(sender.address)
+				e.recordNonce(sender, opts.Nonce.Uint64(), txHash, opts.GasPrice)
+				return nil
 
+			default:
 				if strings.Contains(err.Error(), "VM Exception") {
 					// a VM execution consumes gas and nonce is increasing
-					e.nonceCache.Incr(e.fromAddress)
+					e.nonceCache.Incr(sender.address)
+					e.recordNonce(sender, opts.Nonce.Uint64(), txHash, opts.GasPrice)
 					return err
 				}
 
@@ -197,6 +480,7 @@ func (e *ethCommitter) SendTx(
 		}
 	}); err != nil {
 		metrics.ReportFuncError(e.svcTags)
+		metrics.ReportFuncError(sender.svcTags)
 
 		log.WithError(err).Errorln("SendTx serialize failed")
 
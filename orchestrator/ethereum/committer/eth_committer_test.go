@@ -0,0 +1,75 @@
+package committer
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/InjectiveLabs/peggo/orchestrator/ethereum/util"
+)
+
+func TestAcquireSender(t *testing.T) {
+	t.Parallel()
+
+	funded := common.HexToAddress("0x9924e52Fe6B833657335C4a71c8347fb2750742b")
+	broke := common.HexToAddress("0x8D983cb9388EaC77af0474fA441C4815500Cb7BB")
+
+	newCommitter := func() *ethCommitter {
+		opts := defaultOptions()
+		opts.MinSenderBalance = big.NewInt(100)
+
+		e := &ethCommitter{
+			committerOpts: opts,
+			nonceCache:    util.NewNonceCache(),
+			evmProvider: &mockProvider{
+				balanceAtFn: func(_ context.Context, account common.Address) (*big.Int, error) {
+					if account == broke {
+						return big.NewInt(1), nil
+					}
+
+					return big.NewInt(1000), nil
+				},
+				nonceAtFn: func(context.Context, common.Address) (uint64, error) {
+					return 0, nil
+				},
+			},
+			sendersByAddr: make(map[common.Address]*senderAccount),
+		}
+
+		for _, addr := range []common.Address{funded, broke} {
+			sender := &senderAccount{address: addr}
+			e.senders = append(e.senders, sender)
+			e.sendersByAddr[addr] = sender
+		}
+
+		return e
+	}
+
+	t.Run("picks the funded account", func(t *testing.T) {
+		t.Parallel()
+
+		e := newCommitter()
+		sender, err := e.acquireSender(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, funded, sender.address)
+	})
+
+	t.Run("fails fast when the free accounts are underfunded", func(t *testing.T) {
+		t.Parallel()
+
+		e := newCommitter()
+		require.True(t, e.tryMarkBusy(e.sendersByAddr[funded]))
+
+		ctx, cancelFn := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancelFn()
+
+		_, err := e.acquireSender(ctx)
+		assert.EqualError(t, err, "no sender account has sufficient balance")
+		assert.NoError(t, ctx.Err())
+	})
+}
@@ -0,0 +1,328 @@
+package committer
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+
+	"github.com/InjectiveLabs/peggo/orchestrator/ethereum/provider"
+)
+
+// TxKind distinguishes txs sent to the Peggy contract, so that each kind can be priced differently.
+type TxKind string
+
+const (
+	TxKindDefault      TxKind = "default"
+	TxKindValsetUpdate TxKind = "valset"
+	TxKindBatch        TxKind = "batch"
+)
+
+// GasPriceRequest describes the tx that needs a gas price.
+type GasPriceRequest struct {
+	Kind TxKind
+
+	// DeadlineHeight is the Ethereum block height after which the tx is useless
+	// (e.g. the batch timeout), or 0 if the tx has no deadline.
+	DeadlineHeight uint64
+}
+
+// TxOption customizes a single SendTx call.
+type TxOption func(req *GasPriceRequest)
+
+// WithTxKind selects the gas price strategy configured for the tx kind.
+func WithTxKind(kind TxKind) TxOption {
+	return func(req *GasPriceRequest) {
+		req.Kind = kind
+	}
+}
+
+// WithDeadlineHeight lets urgency-aware pricers pay more as the deadline approaches.
+func WithDeadlineHeight(height uint64) TxOption {
+	return func(req *GasPriceRequest) {
+		req.DeadlineHeight = height
+	}
+}
+
+// GasPricer decides the gas price of a tx.
+type GasPricer interface {
+	GasPrice(ctx context.Context, req GasPriceRequest) (*big.Int, error)
+}
+
+// GasPricerFunc is an adapter to use ordinary functions as GasPricer.
+type GasPricerFunc func(ctx context.Context, req GasPriceRequest) (*big.Int, error)
+
+func (fn GasPricerFunc) GasPrice(ctx context.Context, req GasPriceRequest) (*big.Int, error) {
+	return fn(ctx, req)
+}
+
+// ParseGasPrice parses a gas price such as "500gwei" or "1000000000" (wei).
+func ParseGasPrice(gasPriceStr string) (*big.Int, error) {
+	gasPriceStr = strings.ToLower(strings.TrimSpace(gasPriceStr))
+
+	// If the denom is gwei, convert to wei
+	unit := "gwei"
+	isGwei := false
+	if strings.HasSuffix(gasPriceStr, unit) {
+		gasPriceStr = strings.TrimSuffix(gasPriceStr, unit)
+		isGwei = true
+	}
+
+	// if denom is not present, consider it as wei
+	gasPriceStr = strings.TrimSuffix(strings.TrimSpace(gasPriceStr), "wei")
+	gasPrice, err := decimal.NewFromString(strings.TrimSpace(gasPriceStr))
+	if err != nil {
+		err = errors.Wrap(err, "unable to parse gas price, expected a value in wei or gwei (e.g. 500gwei)")
+		return nil, err
+	} else if gasPrice.IsNegative() {
+		return nil, errors.New("gas price must not be negative")
+	}
+
+	if isGwei {
+		gasPrice = gasPrice.Shift(9) // Gwei to wei
+	}
+
+	return gasPrice.BigInt(), nil
+}
+
+// capGasPrice rejects prices above maxGasPrice, in that case the network is too expensive to relay right now.
+func capGasPrice(gasPrice, maxGasPrice *big.Int) (*big.Int, error) {
+	if maxGasPrice != nil && gasPrice.Cmp(maxGasPrice) > 0 {
+		return nil, errors.Errorf("gas price %s is greater than max gas price %s", gasPrice, maxGasPrice)
+	}
+
+	return gasPrice, nil
+}
+
+func mulBigFloat(v *big.Int, factor float64) *big.Int {
+	res, _ := new(big.Float).Mul(new(big.Float).SetInt(v), big.NewFloat(factor)).Int(nil)
+	return res
+}
+
+type gasPriceSuggester interface {
+	SuggestGasPrice(ctx context.Context) (*big.Int, error)
+}
+
+// NewSuggestedGasPricer uses the node's eth_gasPrice multiplied by the adjustment factor.
+func NewSuggestedGasPricer(provider gasPriceSuggester, adjustment float64) GasPricer {
+	return GasPricerFunc(func(ctx context.Context, _ GasPriceRequest) (*big.Int, error) {
+		suggested, err := provider.SuggestGasPrice(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to suggest gas price")
+		}
+
+		// Suggested gas price is not accurate. Increment by multiplying with gasprice adjustment factor
+		return mulBigFloat(suggested, adjustment), nil
+	})
+}
+
+// NewFixedGasPricer always uses the same gas price.
+func NewFixedGasPricer(gasPrice *big.Int) GasPricer {
+	return GasPricerFunc(func(context.Context, GasPriceRequest) (*big.Int, error) {
+		return new(big.Int).Set(gasPrice), nil
+	})
+}
+
+type feeHistoryProvider interface {
+	FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error)
+}
+
+// NewFeeHistoryGasPricer prices txs as the next block base fee (with headroom for a full block)
+// plus the median over recent blocks of the priority fee paid at the given percentile.
+func NewFeeHistoryGasPricer(provider feeHistoryProvider, blocks uint64, percentile float64) GasPricer {
+	return GasPricerFunc(func(ctx context.Context, _ GasPriceRequest) (*big.Int, error) {
+		history, err := provider.FeeHistory(ctx, blocks, nil, []float64{percentile})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get fee history")
+		}
+
+		if len(history.BaseFee) == 0 {
+			return nil, errors.New("empty fee history")
+		}
+
+		tips := make([]*big.Int, 0, len(history.Reward))
+		for _, rewards := range history.Reward {
+			if len(rewards) > 0 && rewards[0] != nil {
+				tips = append(tips, rewards[0])
+			}
+		}
+
+		tip := new(big.Int)
+		if len(tips) > 0 {
+			sort.Slice(tips, func(i, j int) bool { return tips[i].Cmp(tips[j]) < 0 })
+			tip = tips[len(tips)/2]
+		}
+
+		// the last base fee is the one of the next block, it may rise up to 12.5% per block
+		nextBaseFee := history.BaseFee[len(history.BaseFee)-1]
+		baseFee := mulBigFloat(nextBaseFee, 1.125)
+
+		return new(big.Int).Add(baseFee, tip), nil
+	})
+}
+
+// NewGasStationGasPricer queries an HTTP gas station returning JSON. The field is a dot-separated
+// path to a price in gwei, e.g. "fast.maxFee" or "result.FastGasPrice".
+func NewGasStationGasPricer(url, field string, timeout time.Duration) GasPricer {
+	client := &http.Client{Timeout: timeout}
+	path := strings.Split(field, ".")
+
+	return GasPricerFunc(func(ctx context.Context, _ GasPriceRequest) (*big.Int, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to query gas station")
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			return nil, errors.Errorf("gas station returned status %d", resp.StatusCode)
+		}
+
+		var body interface{}
+		if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+			return nil, errors.Wrap(err, "failed to decode gas station response")
+		}
+
+		value := body
+		for _, key := range path {
+			obj, ok := value.(map[string]interface{})
+			if !ok {
+				return nil, errors.Errorf("gas station response has no field %s", field)
+			}
+
+			value = obj[key]
+		}
+
+		var gwei decimal.Decimal
+		switch v := value.(type) {
+		case float64:
+			gwei = decimal.NewFromFloat(v)
+		case string:
+			if gwei, err = decimal.NewFromString(v); err != nil {
+				return nil, errors.Wrapf(err, "invalid gas price in gas station field %s", field)
+			}
+		default:
+			return nil, errors.Errorf("gas station field %s is not a number", field)
+		}
+
+		return gwei.Shift(9).BigInt(), nil
+	})
+}
+
+// NewUrgencyGasPricer raises the price of txs that have a deadline. Within window blocks
+// before the deadline the price ramps up linearly to maxMultiplier times the base price.
+// The boost never lifts the price above maxGasPrice, but a base price that's already
+// above the cap is passed through, so the tx is still rejected as too expensive.
+func NewUrgencyGasPricer(
+	base GasPricer,
+	currentHeight func(ctx context.Context) (uint64, error),
+	window uint64,
+	maxMultiplier float64,
+	maxGasPrice *big.Int,
+) GasPricer {
+	return GasPricerFunc(func(ctx context.Context, req GasPriceRequest) (*big.Int, error) {
+		price, err := base.GasPrice(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		if req.DeadlineHeight == 0 || window == 0 || maxMultiplier <= 1 {
+			return price, nil
+		}
+
+		height, err := currentHeight(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get current block height")
+		}
+
+		multiplier := urgencyMultiplier(height, req.DeadlineHeight, window, maxMultiplier)
+		if multiplier == 1 {
+			return price, nil
+		}
+
+		return clampGasPrice(price, mulBigFloat(price, multiplier), maxGasPrice), nil
+	})
+}
+
+// urgencyMultiplier grows linearly from 1 at window blocks before the deadline up to maxMultiplier at the deadline.
+func urgencyMultiplier(height, deadline, window uint64, maxMultiplier float64) float64 {
+	if height >= deadline {
+		return maxMultiplier
+	}
+
+	remaining := deadline - height
+	if remaining >= window {
+		return 1
+	}
+
+	return 1 + (maxMultiplier-1)*float64(window-remaining)/float64(window)
+}
+
+// clampGasPrice limits a boosted price to maxGasPrice, without going below the base price.
+func clampGasPrice(basePrice, boostedPrice, maxGasPrice *big.Int) *big.Int {
+	if maxGasPrice == nil || boostedPrice.Cmp(maxGasPrice) <= 0 {
+		return boostedPrice
+	}
+
+	if basePrice.Cmp(maxGasPrice) > 0 {
+		return basePrice
+	}
+
+	return new(big.Int).Set(maxGasPrice)
+}
+
+// GasPricerConfig selects and configures a gas price strategy.
+type GasPricerConfig struct {
+	// Strategy is one of: suggested, feehistory, fixed, gasstation.
+	Strategy string
+
+	FixedGasPrice *big.Int
+
+	FeeHistoryBlocks     uint64
+	FeeHistoryPercentile float64
+
+	GasStationURL   string
+	GasStationField string
+}
+
+// NewGasPricer builds the gas pricer described by the config.
+func NewGasPricer(cfg GasPricerConfig, evmProvider provider.EVMProvider, adjustment float64) (GasPricer, error) {
+	switch strings.ToLower(strings.TrimSpace(cfg.Strategy)) {
+	case "", "suggested":
+		return NewSuggestedGasPricer(evmProvider, adjustment), nil
+	case "feehistory":
+		if cfg.FeeHistoryBlocks == 0 {
+			return nil, errors.New("fee history block count must be positive")
+		} else if cfg.FeeHistoryPercentile < 0 || cfg.FeeHistoryPercentile > 100 {
+			return nil, errors.New("fee history percentile must be within [0, 100]")
+		}
+
+		return NewFeeHistoryGasPricer(evmProvider, cfg.FeeHistoryBlocks, cfg.FeeHistoryPercentile), nil
+	case "fixed":
+		if cfg.FixedGasPrice == nil || cfg.FixedGasPrice.Sign() <= 0 {
+			return nil, errors.New("fixed gas price must be positive")
+		}
+
+		return NewFixedGasPricer(cfg.FixedGasPrice), nil
+	case "gasstation":
+		if len(cfg.GasStationURL) == 0 || len(cfg.GasStationField) == 0 {
+			return nil, errors.New("gas station URL and field must be set")
+		}
+
+		return NewGasStationGasPricer(cfg.GasStationURL, cfg.GasStationField, 10*time.Second), nil
+	default:
+		return nil, errors.Errorf("unknown gas price strategy %s, expected one of: suggested, feehistory, fixed, gasstation", cfg.Strategy)
+	}
+}
@@ -0,0 +1,170 @@
+package committer
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func gwei(v int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(v), big.NewInt(1e9))
+}
+
+func TestParseGasPrice(t *testing.T) {
+	t.Parallel()
+
+	price, err := ParseGasPrice("500gwei")
+	assert.NoError(t, err)
+	assert.Equal(t, gwei(500), price)
+
+	price, err = ParseGasPrice("1000000000")
+	assert.NoError(t, err)
+	assert.Equal(t, gwei(1), price)
+
+	price, err = ParseGasPrice(" 1.5 GWei ")
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(1500000000), price)
+
+	_, err = ParseGasPrice("cheap")
+	assert.Error(t, err)
+
+	_, err = ParseGasPrice("-1gwei")
+	assert.Error(t, err)
+
+	_, err = ParseMaxGasPrice("500 gwei please")
+	assert.Error(t, err)
+}
+
+func TestCapGasPrice(t *testing.T) {
+	t.Parallel()
+
+	t.Run("below max", func(t *testing.T) {
+		t.Parallel()
+
+		price, err := capGasPrice(gwei(100), gwei(500))
+		assert.NoError(t, err)
+		assert.Equal(t, gwei(100), price)
+	})
+
+	t.Run("equal to max", func(t *testing.T) {
+		t.Parallel()
+
+		price, err := capGasPrice(gwei(500), gwei(500))
+		assert.NoError(t, err)
+		assert.Equal(t, gwei(500), price)
+	})
+
+	t.Run("above max", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := capGasPrice(gwei(501), gwei(500))
+		assert.Error(t, err)
+	})
+
+	t.Run("no max", func(t *testing.T) {
+		t.Parallel()
+
+		price, err := capGasPrice(gwei(10000), nil)
+		assert.NoError(t, err)
+		assert.Equal(t, gwei(10000), price)
+	})
+}
+
+func TestClampGasPrice(t *testing.T) {
+	t.Parallel()
+
+	// boost within the cap is kept
+	assert.Equal(t, gwei(150), clampGasPrice(gwei(100), gwei(150), gwei(500)))
+
+	// boost is limited to the cap
+	assert.Equal(t, gwei(500), clampGasPrice(gwei(300), gwei(600), gwei(500)))
+
+	// base above the cap is passed through, so capGasPrice rejects it
+	assert.Equal(t, gwei(600), clampGasPrice(gwei(600), gwei(1200), gwei(500)))
+
+	// no cap
+	assert.Equal(t, gwei(1200), clampGasPrice(gwei(600), gwei(1200), nil))
+}
+
+func TestUrgencyMultiplier(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, 1.0, urgencyMultiplier(100, 200, 50, 3))
+	assert.Equal(t, 1.0, urgencyMultiplier(150, 200, 50, 3))
+	assert.Equal(t, 2.0, urgencyMultiplier(175, 200, 50, 3))
+	assert.Equal(t, 3.0, urgencyMultiplier(200, 200, 50, 3))
+	assert.Equal(t, 3.0, urgencyMultiplier(250, 200, 50, 3))
+}
+
+func TestUrgencyGasPricer(t *testing.T) {
+	t.Parallel()
+
+	height := func(context.Context) (uint64, error) { return 175, nil }
+	pricer := NewUrgencyGasPricer(NewFixedGasPricer(gwei(100)), height, 50, 3, gwei(250))
+
+	// no deadline, no boost
+	price, err := pricer.GasPrice(context.Background(), GasPriceRequest{Kind: TxKindValsetUpdate})
+	assert.NoError(t, err)
+	assert.Equal(t, gwei(100), price)
+
+	// halfway through the window
+	price, err = pricer.GasPrice(context.Background(), GasPriceRequest{Kind: TxKindBatch, DeadlineHeight: 200})
+	assert.NoError(t, err)
+	assert.Equal(t, gwei(200), price)
+
+	// at the deadline the boost hits the cap
+	price, err = pricer.GasPrice(context.Background(), GasPriceRequest{Kind: TxKindBatch, DeadlineHeight: 175})
+	assert.NoError(t, err)
+	assert.Equal(t, gwei(250), price)
+}
+
+func TestGasStationGasPricer(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"status":"1","result":{"SafeGasPrice":"30","FastGasPrice":"42.5"},"fast":{"maxFee":55}}`))
+	}))
+	defer ts.Close()
+
+	price, err := NewGasStationGasPricer(ts.URL, "result.FastGasPrice", time.Second).GasPrice(context.Background(), GasPriceRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(42500000000), price)
+
+	price, err = NewGasStationGasPricer(ts.URL, "fast.maxFee", time.Second).GasPrice(context.Background(), GasPriceRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, gwei(55), price)
+
+	_, err = NewGasStationGasPricer(ts.URL, "result.Missing", time.Second).GasPrice(context.Background(), GasPriceRequest{})
+	assert.Error(t, err)
+
+	_, err = NewGasStationGasPricer(ts.URL, "status.value", time.Second).GasPrice(context.Background(), GasPriceRequest{})
+	assert.Error(t, err)
+}
+
+func TestNewGasPricer(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewGasPricer(GasPricerConfig{Strategy: "fixed"}, nil, 1)
+	assert.Error(t, err)
+
+	_, err = NewGasPricer(GasPricerConfig{Strategy: "feehistory", FeeHistoryBlocks: 10, FeeHistoryPercentile: 101}, nil, 1)
+	assert.Error(t, err)
+
+	_, err = NewGasPricer(GasPricerConfig{Strategy: "gasstation"}, nil, 1)
+	assert.Error(t, err)
+
+	_, err = NewGasPricer(GasPricerConfig{Strategy: "oracle"}, nil, 1)
+	assert.Error(t, err)
+
+	pricer, err := NewGasPricer(GasPricerConfig{Strategy: "fixed", FixedGasPrice: gwei(7)}, nil, 1)
+	assert.NoError(t, err)
+
+	price, err := pricer.GasPrice(context.Background(), GasPriceRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, gwei(7), price)
+}
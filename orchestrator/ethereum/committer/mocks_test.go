@@ -0,0 +1,47 @@
+package committer
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/InjectiveLabs/peggo/orchestrator/ethereum/provider"
+)
+
+// mockProvider implements the provider calls the committer makes, others panic.
+type mockProvider struct {
+	provider.EVMProviderWithRet
+
+	nonceAtFn                func(context.Context, common.Address) (uint64, error)
+	pendingNonceAtFn         func(context.Context, common.Address) (uint64, error)
+	balanceAtFn              func(context.Context, common.Address) (*big.Int, error)
+	suggestGasPriceFn        func(context.Context) (*big.Int, error)
+	transactionByHashFn      func(context.Context, common.Hash) (*types.Transaction, bool, error)
+	sendTransactionWithRetFn func(context.Context, *types.Transaction) (common.Hash, error)
+}
+
+func (p *mockProvider) NonceAt(ctx context.Context, account common.Address, _ *big.Int) (uint64, error) {
+	return p.nonceAtFn(ctx, account)
+}
+
+func (p *mockProvider) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	return p.pendingNonceAtFn(ctx, account)
+}
+
+func (p *mockProvider) BalanceAt(ctx context.Context, account common.Address, _ *big.Int) (*big.Int, error) {
+	return p.balanceAtFn(ctx, account)
+}
+
+func (p *mockProvider) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return p.suggestGasPriceFn(ctx)
+}
+
+func (p *mockProvider) TransactionByHash(ctx context.Context, txHash common.Hash) (*types.Transaction, bool, error) {
+	return p.transactionByHashFn(ctx, txHash)
+}
+
+func (p *mockProvider) SendTransactionWithRet(ctx context.Context, tx *types.Transaction) (common.Hash, error) {
+	return p.sendTransactionWithRetFn(ctx, tx)
+}
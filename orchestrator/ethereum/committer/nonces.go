@@ -0,0 +1,241 @@
+package committer
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	log "github.com/xlab/suplog"
+
+	"github.com/InjectiveLabs/peggo/orchestrator/ethereum/util"
+	"github.com/InjectiveLabs/peggo/orchestrator/metrics"
+)
+
+const (
+	// nonceRecoveryInterval is how often a sender's nonces are reconciled with the chain before sending.
+	nonceRecoveryInterval = 5 * time.Minute
+
+	// selfTransferGasLimit is the gas needed for a plain zero-value transfer.
+	selfTransferGasLimit = 21000
+)
+
+// recordNonce stores a broadcast tx in the journal. A failure is only logged,
+// since the tx has been sent already.
+func (e *ethCommitter) recordNonce(sender *senderAccount, nonce uint64, txHash common.Hash, gasPrice *big.Int) {
+	rec := util.NonceRecord{
+		Nonce:    nonce,
+		TxHash:   txHash,
+		GasPrice: gasPrice,
+		SentAt:   time.Now(),
+	}
+
+	if err := e.nonceJournal.Record(sender.address, rec); err != nil {
+		log.WithError(err).WithFields(log.Fields{
+			"account": sender.address.Hex(),
+			"nonce":   nonce,
+			"tx_hash": txHash.Hex(),
+		}).Errorln("failed to record nonce in journal")
+	}
+}
+
+// recoverNonces reconciles the sender's nonce with the chain and the journal of used nonces.
+// Nonces that were used but are neither mined nor known to the node leave a gap that blocks
+// all later txs, so they are filled with zero-value self-transfers. Txs that are stuck in
+// the pool for longer than StuckTxTimeout are re-sent as they are, with a bumped gas price.
+func (e *ethCommitter) recoverNonces(ctx context.Context, sender *senderAccount) error {
+	metrics.ReportFuncCall(sender.svcTags)
+	doneFn := metrics.ReportFuncTiming(sender.svcTags)
+	defer doneFn()
+
+	logger := log.WithField("account", sender.address.Hex())
+
+	rpcCtx, cancelFn := context.WithTimeout(ctx, e.committerOpts.RPCTimeout)
+	defer cancelFn()
+
+	minedNonce, err := e.evmProvider.NonceAt(rpcCtx, sender.address, nil)
+	if err != nil {
+		metrics.ReportFuncError(sender.svcTags)
+		return errors.Wrap(err, "failed to get mined nonce")
+	}
+
+	pendingNonce, err := e.evmProvider.PendingNonceAt(rpcCtx, sender.address)
+	if err != nil {
+		metrics.ReportFuncError(sender.svcTags)
+		return errors.Wrap(err, "failed to get pending nonce")
+	}
+
+	if err := e.nonceJournal.Prune(sender.address, minedNonce); err != nil {
+		logger.WithError(err).Warningln("failed to prune nonce journal")
+	}
+
+	records := e.nonceJournal.Records(sender.address)
+	byNonce := make(map[uint64]util.NonceRecord, len(records))
+	nextNonce := pendingNonce
+	for _, rec := range records {
+		byNonce[rec.Nonce] = rec
+		if rec.Nonce+1 > nextNonce {
+			nextNonce = rec.Nonce + 1
+		}
+	}
+
+	for nonce := minedNonce; nonce < nextNonce; nonce++ {
+		rec, journaled := byNonce[nonce]
+
+		switch {
+		case nonce >= pendingNonce:
+			// not part of the executable pending sequence: the tx is either queued behind
+			// a gap, or it has been dropped and the nonce is a gap itself
+			if journaled && e.isKnownTx(ctx, rec.TxHash) {
+				continue
+			}
+
+			logger.WithFields(log.Fields{
+				"nonce":         nonce,
+				"mined_nonce":   minedNonce,
+				"pending_nonce": pendingNonce,
+				"dropped_tx":    rec.TxHash.Hex(),
+			}).Warningln("detected nonce gap, filling it with a self-transfer")
+
+			if err := e.sendSelfTransfer(ctx, sender, nonce, rec.GasPrice); err != nil {
+				metrics.ReportFuncError(sender.svcTags)
+				return errors.Wrapf(err, "failed to fill nonce gap %d", nonce)
+			}
+
+		case journaled && e.committerOpts.StuckTxTimeout > 0 && time.Since(rec.SentAt) > e.committerOpts.StuckTxTimeout:
+			logger.WithFields(log.Fields{
+				"nonce":     nonce,
+				"stuck_tx":  rec.TxHash.Hex(),
+				"sent_at":   rec.SentAt,
+				"gas_price": rec.GasPrice,
+			}).Warningln("tx is stuck in the pool, re-sending it with a bumped gas price")
+
+			if err := e.resendStuckTx(ctx, sender, rec); err != nil {
+				metrics.ReportFuncError(sender.svcTags)
+				logger.WithError(err).WithField("nonce", nonce).Errorln("failed to re-send stuck tx")
+			}
+		}
+	}
+
+	e.nonceCache.Set(sender.address, int64(nextNonce))
+	sender.lastRecovery = time.Now()
+
+	if nextNonce != pendingNonce {
+		logger.WithFields(log.Fields{
+			"mined_nonce":   minedNonce,
+			"pending_nonce": pendingNonce,
+			"next_nonce":    nextNonce,
+		}).Infoln("recovered sender nonce from journal")
+	}
+
+	return nil
+}
+
+func (e *ethCommitter) isKnownTx(ctx context.Context, txHash common.Hash) bool {
+	if txHash == (common.Hash{}) {
+		return false
+	}
+
+	ctx, cancelFn := context.WithTimeout(ctx, e.committerOpts.RPCTimeout)
+	defer cancelFn()
+
+	tx, _, err := e.evmProvider.TransactionByHash(ctx, txHash)
+	return err == nil && tx != nil
+}
+
+// replacementGasPrice is the suggested gas price, bumped above the previous tx at the
+// same nonce (if any), so the node accepts the new tx as a replacement.
+func (e *ethCommitter) replacementGasPrice(ctx context.Context, prevGasPrice *big.Int) (*big.Int, error) {
+	gasPrice, err := e.evmProvider.SuggestGasPrice(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to suggest gas price")
+	}
+
+	if prevGasPrice != nil {
+		// nodes require at least a 10% bump to replace a tx
+		bumped := new(big.Int).Div(new(big.Int).Mul(prevGasPrice, big.NewInt(125)), big.NewInt(100))
+		if bumped.Cmp(gasPrice) > 0 {
+			gasPrice = bumped
+		}
+	}
+
+	return capGasPrice(gasPrice, e.ethMaxGasPrice)
+}
+
+// resendStuckTx re-sends a journaled tx that is still in the pool with a bumped gas price,
+// so a relay waiting out a gas spike isn't lost.
+func (e *ethCommitter) resendStuckTx(ctx context.Context, sender *senderAccount, rec util.NonceRecord) error {
+	rpcCtx, cancelFn := context.WithTimeout(ctx, e.committerOpts.RPCTimeout)
+	defer cancelFn()
+
+	stuckTx, _, err := e.evmProvider.TransactionByHash(rpcCtx, rec.TxHash)
+	if err != nil || stuckTx == nil {
+		return errors.Errorf("stuck tx %s not found in the pool", rec.TxHash.Hex())
+	} else if stuckTx.To() == nil {
+		return errors.Errorf("stuck tx %s is a contract creation", rec.TxHash.Hex())
+	}
+
+	gasPrice, err := e.replacementGasPrice(rpcCtx, rec.GasPrice)
+	if err != nil {
+		return errors.Wrap(err, "can't bump gas price")
+	}
+
+	tx := types.NewTransaction(rec.Nonce, *stuckTx.To(), stuckTx.Value(), stuckTx.Gas(), gasPrice, stuckTx.Data())
+	signedTx, err := sender.signer(sender.address, tx)
+	if err != nil {
+		return errors.Wrap(err, "failed to sign replacement tx")
+	}
+
+	txHash, err := e.evmProvider.SendTransactionWithRet(rpcCtx, signedTx)
+	if err != nil {
+		return errors.Wrap(err, "failed to send replacement tx")
+	}
+
+	e.recordNonce(sender, rec.Nonce, txHash, gasPrice)
+
+	log.WithFields(log.Fields{
+		"account":   sender.address.Hex(),
+		"nonce":     rec.Nonce,
+		"stuck_tx":  rec.TxHash.Hex(),
+		"tx_hash":   txHash.Hex(),
+		"gas_price": gasPrice.String(),
+	}).Infoln("re-sent stuck tx with a bumped gas price")
+
+	return nil
+}
+
+// sendSelfTransfer occupies the nonce with a zero-value transfer to the sender itself. The gas price
+// is bumped above the previous tx at this nonce (if any), so the node accepts it as a replacement.
+func (e *ethCommitter) sendSelfTransfer(ctx context.Context, sender *senderAccount, nonce uint64, prevGasPrice *big.Int) error {
+	rpcCtx, cancelFn := context.WithTimeout(ctx, e.committerOpts.RPCTimeout)
+	defer cancelFn()
+
+	gasPrice, err := e.replacementGasPrice(rpcCtx, prevGasPrice)
+	if err != nil {
+		return errors.Wrap(err, "can't fill nonce")
+	}
+
+	tx := types.NewTransaction(nonce, sender.address, new(big.Int), selfTransferGasLimit, gasPrice, nil)
+	signedTx, err := sender.signer(sender.address, tx)
+	if err != nil {
+		return errors.Wrap(err, "failed to sign self-transfer")
+	}
+
+	txHash, err := e.evmProvider.SendTransactionWithRet(rpcCtx, signedTx)
+	if err != nil {
+		return errors.Wrap(err, "failed to send self-transfer")
+	}
+
+	e.recordNonce(sender, nonce, txHash, gasPrice)
+
+	log.WithFields(log.Fields{
+		"account":   sender.address.Hex(),
+		"nonce":     nonce,
+		"tx_hash":   txHash.Hex(),
+		"gas_price": gasPrice.String(),
+	}).Infoln("sent self-transfer to occupy nonce")
+
+	return nil
+}
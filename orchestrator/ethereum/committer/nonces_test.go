@@ -0,0 +1,157 @@
+package committer
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/InjectiveLabs/peggo/orchestrator/ethereum/util"
+)
+
+func TestRecoverNonces(t *testing.T) {
+	t.Parallel()
+
+	account := common.HexToAddress("0x9924e52Fe6B833657335C4a71c8347fb2750742b")
+	peggy := common.HexToAddress("0x3c2A9d3d5b4bE9bC5B1a4a0D8A8aD8cE2c1B7A4e")
+	gwei := big.NewInt(1000000000)
+
+	relayTx := func(nonce uint64) *types.Transaction {
+		return types.NewTransaction(nonce, peggy, new(big.Int), 500000, gwei, []byte{0xde, 0xad, 0xbe, 0xef})
+	}
+
+	// newCommitter returns a committer whose node has mined and pending nonces at 5 and 6,
+	// and knows the txs in the pool
+	newCommitter := func(t *testing.T, pool map[common.Hash]*types.Transaction, records ...util.NonceRecord) (*ethCommitter, *[]*types.Transaction) {
+		journal, err := util.NewNonceJournal("")
+		require.NoError(t, err)
+
+		for _, rec := range records {
+			require.NoError(t, journal.Record(account, rec))
+		}
+
+		var sent []*types.Transaction
+		e := &ethCommitter{
+			committerOpts: defaultOptions(),
+			nonceCache:    util.NewNonceCache(),
+			nonceJournal:  journal,
+			evmProvider: &mockProvider{
+				nonceAtFn: func(context.Context, common.Address) (uint64, error) {
+					return 5, nil
+				},
+				pendingNonceAtFn: func(context.Context, common.Address) (uint64, error) {
+					return 6, nil
+				},
+				suggestGasPriceFn: func(context.Context) (*big.Int, error) {
+					return gwei, nil
+				},
+				transactionByHashFn: func(_ context.Context, txHash common.Hash) (*types.Transaction, bool, error) {
+					if tx, ok := pool[txHash]; ok {
+						return tx, true, nil
+					}
+
+					return nil, false, errors.New("not found")
+				},
+				sendTransactionWithRetFn: func(_ context.Context, tx *types.Transaction) (common.Hash, error) {
+					sent = append(sent, tx)
+					return tx.Hash(), nil
+				},
+			},
+		}
+
+		e.committerOpts.StuckTxTimeout = 30 * time.Minute
+
+		return e, &sent
+	}
+
+	newSender := func() *senderAccount {
+		return &senderAccount{
+			address: account,
+			signer: func(_ common.Address, tx *types.Transaction) (*types.Transaction, error) {
+				return tx, nil
+			},
+		}
+	}
+
+	t.Run("fills a gap left by a dropped tx", func(t *testing.T) {
+		t.Parallel()
+
+		queued := relayTx(7)
+		e, sent := newCommitter(t,
+			map[common.Hash]*types.Transaction{queued.Hash(): queued},
+			util.NonceRecord{Nonce: 5, TxHash: relayTx(5).Hash(), GasPrice: gwei, SentAt: time.Now()},
+			util.NonceRecord{Nonce: 6, TxHash: common.HexToHash("0x06"), GasPrice: gwei, SentAt: time.Now()},
+			util.NonceRecord{Nonce: 7, TxHash: queued.Hash(), GasPrice: gwei, SentAt: time.Now()},
+		)
+
+		require.NoError(t, e.recoverNonces(context.Background(), newSender()))
+
+		// nonce 5 is pending, 6 was dropped and 7 is queued behind it
+		require.Len(t, *sent, 1)
+		fill := (*sent)[0]
+		assert.Equal(t, uint64(6), fill.Nonce())
+		assert.Equal(t, account, *fill.To())
+		assert.Zero(t, fill.Value().Sign())
+		assert.Empty(t, fill.Data())
+
+		nonce, _ := e.nonceCache.Get(account)
+		assert.Equal(t, int64(8), nonce)
+	})
+
+	t.Run("keeps a queued tx the node still knows", func(t *testing.T) {
+		t.Parallel()
+
+		queued := relayTx(6)
+		e, sent := newCommitter(t,
+			map[common.Hash]*types.Transaction{queued.Hash(): queued},
+			util.NonceRecord{Nonce: 6, TxHash: queued.Hash(), GasPrice: gwei, SentAt: time.Now()},
+		)
+
+		require.NoError(t, e.recoverNonces(context.Background(), newSender()))
+		assert.Empty(t, *sent)
+
+		nonce, _ := e.nonceCache.Get(account)
+		assert.Equal(t, int64(7), nonce)
+	})
+
+	t.Run("re-sends a stuck tx with a bumped gas price", func(t *testing.T) {
+		t.Parallel()
+
+		stuck := relayTx(5)
+		e, sent := newCommitter(t,
+			map[common.Hash]*types.Transaction{stuck.Hash(): stuck},
+			util.NonceRecord{Nonce: 5, TxHash: stuck.Hash(), GasPrice: gwei, SentAt: time.Now().Add(-time.Hour)},
+		)
+
+		require.NoError(t, e.recoverNonces(context.Background(), newSender()))
+
+		require.Len(t, *sent, 1)
+		resent := (*sent)[0]
+		assert.Equal(t, uint64(5), resent.Nonce())
+		assert.Equal(t, peggy, *resent.To())
+		assert.Equal(t, stuck.Data(), resent.Data())
+		assert.Equal(t, stuck.Gas(), resent.Gas())
+		assert.Equal(t, big.NewInt(1250000000), resent.GasPrice())
+
+		records := e.nonceJournal.Records(account)
+		require.Len(t, records, 1)
+		assert.Equal(t, resent.Hash(), records[0].TxHash)
+	})
+
+	t.Run("leaves a stuck tx missing from the pool alone", func(t *testing.T) {
+		t.Parallel()
+
+		e, sent := newCommitter(t, nil,
+			util.NonceRecord{Nonce: 5, TxHash: relayTx(5).Hash(), GasPrice: gwei, SentAt: time.Now().Add(-time.Hour)},
+		)
+
+		require.NoError(t, e.recoverNonces(context.Background(), newSender()))
+		assert.Empty(t, *sent)
+	})
+}
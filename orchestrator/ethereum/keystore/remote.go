@@ -0,0 +1,325 @@
+package keystore
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+)
+
+// RemoteSignerAPI selects the JSON-RPC dialect spoken by the remote signer.
+type RemoteSignerAPI string
+
+const (
+	// RemoteSignerWeb3Signer uses eth_accounts, eth_sign and eth_signTransaction,
+	// as implemented by Web3Signer and most node-compatible signing proxies.
+	RemoteSignerWeb3Signer RemoteSignerAPI = "web3signer"
+
+	// RemoteSignerClef uses the account_* external API of Clef.
+	RemoteSignerClef RemoteSignerAPI = "clef"
+)
+
+// RemoteSignerConfig describes how to reach a remote signing service.
+type RemoteSignerConfig struct {
+	URL string
+	API RemoteSignerAPI
+
+	// TLS settings, all optional. CACert is used to verify the server,
+	// ClientCert and ClientKey enable mutual TLS.
+	CACert     string
+	ClientCert string
+	ClientKey  string
+
+	Timeout time.Duration
+}
+
+// RemoteSigner signs Ethereum transactions and personal messages
+// using keys that are held by a remote service, so they never touch the peggo host.
+type RemoteSigner struct {
+	client  *rpc.Client
+	api     RemoteSignerAPI
+	chainID *big.Int
+	signer  types.Signer
+	timeout time.Duration
+}
+
+// NewRemoteSigner connects to a remote signer over HTTP(S) JSON-RPC.
+func NewRemoteSigner(chainID uint64, cfg RemoteSignerConfig) (*RemoteSigner, error) {
+	switch cfg.API {
+	case RemoteSignerWeb3Signer, RemoteSignerClef:
+	case "":
+		cfg.API = RemoteSignerWeb3Signer
+	default:
+		return nil, errors.Errorf("unsupported remote signer API: %s", cfg.API)
+	}
+
+	if cfg.Timeout == 0 {
+		cfg.Timeout = 10 * time.Second
+	}
+
+	tlsConfig, err := remoteSignerTLSConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient := &http.Client{
+		Timeout: cfg.Timeout,
+		Transport: &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: tlsConfig,
+		},
+	}
+
+	client, err := rpc.DialHTTPWithClient(cfg.URL, httpClient)
+	if err != nil {
+		err = errors.Wrap(err, "failed to dial remote signer")
+		return nil, err
+	}
+
+	id := new(big.Int).SetUint64(chainID)
+	s := &RemoteSigner{
+		client:  client,
+		api:     cfg.API,
+		chainID: id,
+		signer:  types.LatestSignerForChainID(id),
+		timeout: cfg.Timeout,
+	}
+
+	return s, nil
+}
+
+func remoteSignerTLSConfig(cfg RemoteSignerConfig) (*tls.Config, error) {
+	if len(cfg.CACert) == 0 && len(cfg.ClientCert) == 0 && len(cfg.ClientKey) == 0 {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if len(cfg.CACert) > 0 {
+		caPEM, err := ioutil.ReadFile(cfg.CACert)
+		if err != nil {
+			err = errors.Wrap(err, "failed to read remote signer CA certificate")
+			return nil, err
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, errors.New("no valid certificates found in remote signer CA file")
+		}
+
+		tlsConfig.RootCAs = pool
+	}
+
+	if len(cfg.ClientCert) > 0 || len(cfg.ClientKey) > 0 {
+		if len(cfg.ClientCert) == 0 || len(cfg.ClientKey) == 0 {
+			return nil, errors.New("both client certificate and key must be provided for remote signer mTLS")
+		}
+
+		cert, err := tls.LoadX509KeyPair(cfg.ClientCert, cfg.ClientKey)
+		if err != nil {
+			err = errors.Wrap(err, "failed to load remote signer client certificate")
+			return nil, err
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+// Accounts lists addresses the remote signer is able to sign for.
+func (s *RemoteSigner) Accounts(ctx context.Context) ([]common.Address, error) {
+	method := "eth_accounts"
+	if s.api == RemoteSignerClef {
+		method = "account_list"
+	}
+
+	var accounts []common.Address
+	if err := s.client.CallContext(ctx, &accounts, method); err != nil {
+		err = errors.Wrap(err, "failed to list remote signer accounts")
+		return nil, err
+	}
+
+	return accounts, nil
+}
+
+// HasAccount checks that the remote signer holds a key for the given address.
+func (s *RemoteSigner) HasAccount(ctx context.Context, account common.Address) error {
+	accounts, err := s.Accounts(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, acc := range accounts {
+		if acc == account {
+			return nil
+		}
+	}
+
+	return errors.Errorf("account %s is not available on the remote signer", account.Hex())
+}
+
+// PersonalSignFn returns a signing function for EIP-191 personal messages,
+// with the signature in the same [R || S || V] format as local keys produce (V is 0 or 1).
+func (s *RemoteSigner) PersonalSignFn() PersonalSignFn {
+	return func(from common.Address, data []byte) (sig []byte, err error) {
+		ctx, cancelFn := context.WithTimeout(context.Background(), s.timeout)
+		defer cancelFn()
+
+		var res hexutil.Bytes
+		switch s.api {
+		case RemoteSignerClef:
+			err = s.client.CallContext(ctx, &res, "account_signData", "text/plain", from, hexutil.Bytes(data))
+		default:
+			err = s.client.CallContext(ctx, &res, "eth_sign", from, hexutil.Bytes(data))
+		}
+
+		if err != nil {
+			err = errors.Wrap(err, "remote signer failed to sign data")
+			return nil, err
+		}
+
+		if len(res) != 65 {
+			return nil, errors.Errorf("remote signer returned signature of invalid length %d", len(res))
+		}
+
+		sig = []byte(res)
+		if sig[64] >= 27 {
+			sig[64] -= 27
+		}
+
+		return sig, nil
+	}
+}
+
+// remoteTxArgs is the transaction object accepted by both eth_signTransaction and account_signTransaction.
+type remoteTxArgs struct {
+	From     common.MixedcaseAddress  `json:"from"`
+	To       *common.MixedcaseAddress `json:"to,omitempty"`
+	Gas      hexutil.Uint64           `json:"gas"`
+	GasPrice *hexutil.Big             `json:"gasPrice"`
+	Value    *hexutil.Big             `json:"value"`
+	Nonce    hexutil.Uint64           `json:"nonce"`
+	Data     hexutil.Bytes            `json:"data"`
+	ChainID  *hexutil.Big             `json:"chainId,omitempty"`
+}
+
+type clefSignTxResult struct {
+	Raw hexutil.Bytes `json:"raw"`
+}
+
+// SignerFn returns a bind.SignerFn that delegates signing of legacy transactions to the remote signer.
+// The signed transaction is checked to match the request and to be signed by the expected account.
+func (s *RemoteSigner) SignerFn() SignerFn {
+	return func(from common.Address, tx *types.Transaction) (*types.Transaction, error) {
+		ctx, cancelFn := context.WithTimeout(context.Background(), s.timeout)
+		defer cancelFn()
+
+		value := tx.Value()
+		if value == nil {
+			value = new(big.Int)
+		}
+
+		args := remoteTxArgs{
+			From:     common.NewMixedcaseAddress(from),
+			Gas:      hexutil.Uint64(tx.Gas()),
+			GasPrice: (*hexutil.Big)(tx.GasPrice()),
+			Value:    (*hexutil.Big)(value),
+			Nonce:    hexutil.Uint64(tx.Nonce()),
+			Data:     tx.Data(),
+			ChainID:  (*hexutil.Big)(s.chainID),
+		}
+
+		if to := tx.To(); to != nil {
+			recipient := common.NewMixedcaseAddress(*to)
+			args.To = &recipient
+		}
+
+		var raw hexutil.Bytes
+		switch s.api {
+		case RemoteSignerClef:
+			var res clefSignTxResult
+			if err := s.client.CallContext(ctx, &res, "account_signTransaction", args); err != nil {
+				err = errors.Wrap(err, "remote signer failed to sign transaction")
+				return nil, err
+			}
+
+			raw = res.Raw
+		default:
+			if err := s.client.CallContext(ctx, &raw, "eth_signTransaction", args); err != nil {
+				err = errors.Wrap(err, "remote signer failed to sign transaction")
+				return nil, err
+			}
+		}
+
+		signedTx := new(types.Transaction)
+		if err := signedTx.UnmarshalBinary(raw); err != nil {
+			err = errors.Wrap(err, "failed to decode signed transaction from remote signer")
+			return nil, err
+		}
+
+		if err := checkRemoteSignedTx(tx, signedTx); err != nil {
+			return nil, err
+		}
+
+		sender, err := types.Sender(s.signer, signedTx)
+		if err != nil {
+			err = errors.Wrap(err, "failed to recover sender of remotely signed transaction")
+			return nil, err
+		} else if sender != from {
+			return nil, errors.Errorf("remote signer signed with %s instead of %s", sender.Hex(), from.Hex())
+		}
+
+		return signedTx, nil
+	}
+}
+
+// checkRemoteSignedTx makes sure the remote signer didn't alter the transaction it was asked to sign.
+func checkRemoteSignedTx(unsigned, signed *types.Transaction) error {
+	var mismatch []string
+
+	if unsigned.Nonce() != signed.Nonce() {
+		mismatch = append(mismatch, "nonce")
+	}
+
+	if unsigned.Gas() != signed.Gas() {
+		mismatch = append(mismatch, "gas")
+	}
+
+	if unsigned.GasPrice().Cmp(signed.GasPrice()) != 0 {
+		mismatch = append(mismatch, "gasPrice")
+	}
+
+	if unsigned.Value().Cmp(signed.Value()) != 0 {
+		mismatch = append(mismatch, "value")
+	}
+
+	if !bytes.Equal(unsigned.Data(), signed.Data()) {
+		mismatch = append(mismatch, "data")
+	}
+
+	switch {
+	case unsigned.To() == nil && signed.To() == nil:
+	case unsigned.To() == nil || signed.To() == nil, *unsigned.To() != *signed.To():
+		mismatch = append(mismatch, "to")
+	}
+
+	if len(mismatch) > 0 {
+		return errors.Errorf("remotely signed transaction differs from request: %s", strings.Join(mismatch, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,218 @@
+package keystore
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+)
+
+const testChainID = 5
+
+// stubSigner is a minimal local stand-in for a remote signing service.
+type stubSigner struct {
+	key    *ecdsa.PrivateKey
+	tamper bool
+}
+
+func (s *stubSigner) address() common.Address {
+	return crypto.PubkeyToAddress(s.key.PublicKey)
+}
+
+func (s *stubSigner) signData(data hexutil.Bytes) (hexutil.Bytes, error) {
+	sig, err := crypto.Sign(accounts.TextHash(data), s.key)
+	if err != nil {
+		return nil, err
+	}
+
+	// remote signers return V in the 27/28 form
+	sig[64] += 27
+	return sig, nil
+}
+
+func (s *stubSigner) signTx(args remoteTxArgs) (hexutil.Bytes, error) {
+	to := args.To.Address()
+	nonce := uint64(args.Nonce)
+	if s.tamper {
+		nonce++
+	}
+
+	tx := types.NewTransaction(nonce, to, args.Value.ToInt(), uint64(args.Gas), args.GasPrice.ToInt(), args.Data)
+	signedTx, err := types.SignTx(tx, types.LatestSignerForChainID(args.ChainID.ToInt()), s.key)
+	if err != nil {
+		return nil, err
+	}
+
+	return signedTx.MarshalBinary()
+}
+
+type web3SignerAPI struct{ *stubSigner }
+
+func (api web3SignerAPI) Accounts() []common.Address {
+	return []common.Address{api.address()}
+}
+
+func (api web3SignerAPI) Sign(_ common.Address, data hexutil.Bytes) (hexutil.Bytes, error) {
+	return api.signData(data)
+}
+
+func (api web3SignerAPI) SignTransaction(args remoteTxArgs) (hexutil.Bytes, error) {
+	return api.signTx(args)
+}
+
+type clefAPI struct{ *stubSigner }
+
+func (api clefAPI) List() []common.Address {
+	return []common.Address{api.address()}
+}
+
+func (api clefAPI) SignData(_ string, _ common.MixedcaseAddress, data hexutil.Bytes) (hexutil.Bytes, error) {
+	return api.signData(data)
+}
+
+func (api clefAPI) SignTransaction(args remoteTxArgs) (*clefSignTxResult, error) {
+	raw, err := api.signTx(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &clefSignTxResult{Raw: raw}, nil
+}
+
+func newStubServer(t *testing.T, namespace string, api interface{}, useTLS bool) *httptest.Server {
+	srv := rpc.NewServer()
+	if err := srv.RegisterName(namespace, api); err != nil {
+		t.Fatal(err)
+	}
+
+	var ts *httptest.Server
+	if useTLS {
+		ts = httptest.NewTLSServer(srv)
+	} else {
+		ts = httptest.NewServer(srv)
+	}
+
+	t.Cleanup(func() {
+		ts.Close()
+		srv.Stop()
+	})
+
+	return ts
+}
+
+func testRemoteSigner(t *testing.T, s *RemoteSigner, stub *stubSigner) {
+	from := stub.address()
+
+	err := s.HasAccount(context.Background(), from)
+	assert.NoError(t, err)
+
+	err = s.HasAccount(context.Background(), common.HexToAddress("0x1"))
+	assert.Error(t, err)
+
+	msg := []byte("checkpoint")
+	sig, err := s.PersonalSignFn()(from, msg)
+	assert.NoError(t, err)
+	assert.Len(t, sig, 65)
+	assert.True(t, sig[64] < 2)
+
+	pubKey, err := crypto.SigToPub(accounts.TextHash(msg), sig)
+	assert.NoError(t, err)
+	assert.Equal(t, from, crypto.PubkeyToAddress(*pubKey))
+
+	tx := types.NewTransaction(7, common.HexToAddress("0x2"), big.NewInt(0), 21000, big.NewInt(1e9), []byte{0x1})
+	signedTx, err := s.SignerFn()(from, tx)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(7), signedTx.Nonce())
+
+	sender, err := types.Sender(types.LatestSignerForChainID(big.NewInt(testChainID)), signedTx)
+	assert.NoError(t, err)
+	assert.Equal(t, from, sender)
+}
+
+func TestRemoteSigner(t *testing.T) {
+	t.Parallel()
+
+	t.Run("web3signer", func(t *testing.T) {
+		t.Parallel()
+
+		key, _ := crypto.GenerateKey()
+		stub := &stubSigner{key: key}
+		ts := newStubServer(t, "eth", web3SignerAPI{stub}, false)
+
+		s, err := NewRemoteSigner(testChainID, RemoteSignerConfig{URL: ts.URL, API: RemoteSignerWeb3Signer})
+		assert.NoError(t, err)
+
+		testRemoteSigner(t, s, stub)
+	})
+
+	t.Run("clef", func(t *testing.T) {
+		t.Parallel()
+
+		key, _ := crypto.GenerateKey()
+		stub := &stubSigner{key: key}
+		ts := newStubServer(t, "account", clefAPI{stub}, false)
+
+		s, err := NewRemoteSigner(testChainID, RemoteSignerConfig{URL: ts.URL, API: RemoteSignerClef})
+		assert.NoError(t, err)
+
+		testRemoteSigner(t, s, stub)
+	})
+
+	t.Run("tls", func(t *testing.T) {
+		t.Parallel()
+
+		key, _ := crypto.GenerateKey()
+		stub := &stubSigner{key: key}
+		ts := newStubServer(t, "eth", web3SignerAPI{stub}, true)
+
+		caFile := filepath.Join(t.TempDir(), "ca.pem")
+		caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw})
+		assert.NoError(t, ioutil.WriteFile(caFile, caPEM, 0600))
+
+		s, err := NewRemoteSigner(testChainID, RemoteSignerConfig{URL: ts.URL, CACert: caFile})
+		assert.NoError(t, err)
+
+		testRemoteSigner(t, s, stub)
+
+		// without the CA the server certificate is not trusted
+		untrusted, err := NewRemoteSigner(testChainID, RemoteSignerConfig{URL: ts.URL})
+		assert.NoError(t, err)
+
+		_, err = untrusted.Accounts(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("tampered tx is rejected", func(t *testing.T) {
+		t.Parallel()
+
+		key, _ := crypto.GenerateKey()
+		stub := &stubSigner{key: key, tamper: true}
+		ts := newStubServer(t, "eth", web3SignerAPI{stub}, false)
+
+		s, err := NewRemoteSigner(testChainID, RemoteSignerConfig{URL: ts.URL})
+		assert.NoError(t, err)
+
+		tx := types.NewTransaction(1, common.HexToAddress("0x2"), big.NewInt(0), 21000, big.NewInt(1e9), nil)
+		_, err = s.SignerFn()(stub.address(), tx)
+		assert.Error(t, err)
+	})
+
+	t.Run("unsupported api", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewRemoteSigner(testChainID, RemoteSignerConfig{URL: "http://localhost", API: "vault"})
+		assert.Error(t, err)
+	})
+}
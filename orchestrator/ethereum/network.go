@@ -4,6 +4,7 @@ import (
 	"context"
 	"math/big"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
@@ -22,6 +23,9 @@ import (
 
 type Network struct {
 	peggy.PeggyContract
+
+	decimalsMux sync.Mutex
+	decimals    map[ethcmn.Address]uint8
 }
 
 func NewNetwork(
@@ -33,6 +37,7 @@ func NewNetwork(
 	maxGasPrice string,
 	pendingTxWaitDuration string,
 	ethNodeAlchemyWS string,
+	committerOpts ...committer.EVMCommitterOption,
 ) (*Network, error) {
 	evmRPC, err := rpc.Dial(ethNodeRPC)
 	if err != nil {
@@ -45,6 +50,7 @@ func NewNetwork(
 		maxGasPrice,
 		signerFn,
 		provider.NewEVMProvider(evmRPC),
+		committerOpts...,
 	)
 	if err != nil {
 		return nil, err
@@ -73,13 +79,26 @@ func NewNetwork(
 		go peggyContract.SubscribeToPendingTxs(ethNodeAlchemyWS)
 	}
 
-	return &Network{PeggyContract: peggyContract}, nil
+	return &Network{
+		PeggyContract: peggyContract,
+		decimals:      make(map[ethcmn.Address]uint8),
+	}, nil
 }
 
 func (n *Network) FromAddress() ethcmn.Address {
 	return n.PeggyContract.FromAddress()
 }
 
+// BalanceAt returns the latest balance of an account.
+func (n *Network) BalanceAt(ctx context.Context, account ethcmn.Address) (*big.Int, error) {
+	return n.Provider().BalanceAt(ctx, account, nil)
+}
+
+// TransactionReceipt returns the receipt of a mined tx.
+func (n *Network) TransactionReceipt(ctx context.Context, txHash ethcmn.Hash) (*types.Receipt, error) {
+	return n.Provider().TransactionReceipt(ctx, txHash)
+}
+
 func (n *Network) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
 	return n.Provider().HeaderByNumber(ctx, number)
 }
@@ -88,6 +107,47 @@ func (n *Network) GetPeggyID(ctx context.Context) (ethcmn.Hash, error) {
 	return n.PeggyContract.GetPeggyID(ctx, n.FromAddress())
 }
 
+// TokenDecimals returns the decimals of an ERC20 token. They never change, so they're fetched once per token.
+func (n *Network) TokenDecimals(ctx context.Context, tokenAddr ethcmn.Address) (uint8, error) {
+	n.decimalsMux.Lock()
+	decimals, ok := n.decimals[tokenAddr]
+	n.decimalsMux.Unlock()
+
+	if ok {
+		return decimals, nil
+	}
+
+	decimals, err := n.PeggyContract.GetERC20Decimals(ctx, tokenAddr, n.FromAddress())
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to get decimals of token %s", tokenAddr.Hex())
+	}
+
+	n.decimalsMux.Lock()
+	n.decimals[tokenAddr] = decimals
+	n.decimalsMux.Unlock()
+
+	return decimals, nil
+}
+
+// TokenSymbol returns the symbol of an ERC20 token.
+func (n *Network) TokenSymbol(ctx context.Context, tokenAddr ethcmn.Address) (string, error) {
+	return n.PeggyContract.GetERC20Symbol(ctx, tokenAddr, n.FromAddress())
+}
+
+func (n *Network) TransactionByHash(ctx context.Context, txHash ethcmn.Hash) (*types.Transaction, bool, error) {
+	return n.Provider().TransactionByHash(ctx, txHash)
+}
+
+// BatchGasPrice returns the gas price a batch would currently be submitted with.
+func (n *Network) BatchGasPrice(ctx context.Context) (*big.Int, error) {
+	return n.PeggyContract.GasPrice(ctx, committer.WithTxKind(committer.TxKindBatch))
+}
+
+// ValsetGasPrice returns the gas price a valset update would currently be submitted with.
+func (n *Network) ValsetGasPrice(ctx context.Context) (*big.Int, error) {
+	return n.PeggyContract.GasPrice(ctx, committer.WithTxKind(committer.TxKindValsetUpdate))
+}
+
 func (n *Network) GetSendToCosmosEvents(startBlock, endBlock uint64) ([]*wrappers.PeggySendToCosmosEvent, error) {
 	peggyFilterer, err := wrappers.NewPeggyFilterer(n.Address(), n.Provider())
 	if err != nil {
@@ -232,6 +292,14 @@ func (n *Network) GetValsetNonce(ctx context.Context) (*big.Int, error) {
 	return n.PeggyContract.GetValsetNonce(ctx, n.FromAddress())
 }
 
+func (n *Network) GetLastEventNonce(ctx context.Context) (*big.Int, error) {
+	return n.PeggyContract.GetLastEventNonce(ctx, n.FromAddress())
+}
+
+func (n *Network) GetValsetCheckpoint(ctx context.Context) (ethcmn.Hash, error) {
+	return n.PeggyContract.GetValsetCheckpoint(ctx, n.FromAddress())
+}
+
 func (n *Network) SendEthValsetUpdate(
 	ctx context.Context,
 	oldValset *peggytypes.Valset,
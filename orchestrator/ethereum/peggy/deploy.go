@@ -0,0 +1,169 @@
+package peggy
+
+import (
+	"context"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	wrappers "github.com/InjectiveLabs/peggo/solidity/wrappers/Peggy.sol"
+	proxywrappers "github.com/InjectiveLabs/peggo/solidity/wrappers/PeggyProxy.sol"
+	"github.com/InjectiveLabs/sdk-go/chain/peggy/types"
+)
+
+// DeployBackend is what's needed to deploy contracts and wait for them to be mined.
+type DeployBackend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+}
+
+// DeployConfig is the initial state of a new Peggy contract.
+type DeployConfig struct {
+	PeggyID        common.Hash
+	PowerThreshold *big.Int
+	// Valset is the validator set the contract is initialized with, usually the current one on Injective.
+	Valset *types.Valset
+	// CosmosToken deploys the ERC20 of the Cosmos coin, with the whole supply held by Peggy, if set.
+	CosmosToken *CosmosTokenConfig
+}
+
+type CosmosTokenConfig struct {
+	Name     string
+	Symbol   string
+	Decimals uint8
+}
+
+type DeployResult struct {
+	// PeggyContract is the proxy address, the one to use for the bridge.
+	PeggyContract common.Address
+	// PeggyImplementation is the Peggy contract the proxy delegates to.
+	PeggyImplementation common.Address
+	ProxyAdmin          common.Address
+	CosmosTokenContract common.Address
+	Checkpoint          common.Hash
+	TxHashes            []common.Hash
+}
+
+// DeployPeggyContract deploys the Peggy implementation, a ProxyAdmin and a TransparentUpgradeableProxy
+// initialized with the valset in its constructor, like solidity/deployment/deploy-on-evm.sh does.
+// Initializing in the same tx as the proxy deployment leaves no window to front-run initialize.
+// The checkpoint stored by the proxy is then checked against the one signed by the validators.
+func DeployPeggyContract(
+	ctx context.Context,
+	backend DeployBackend,
+	auth *bind.TransactOpts,
+	cfg DeployConfig,
+) (*DeployResult, error) {
+	if cfg.Valset == nil || len(cfg.Valset.Members) == 0 {
+		return nil, errors.New("valset has no members")
+	}
+
+	validators := make([]common.Address, len(cfg.Valset.Members))
+	powers := make([]*big.Int, len(cfg.Valset.Members))
+	for i, m := range cfg.Valset.Members {
+		if !common.IsHexAddress(m.EthereumAddress) {
+			return nil, errors.Errorf("invalid Ethereum address of valset member: %s", m.EthereumAddress)
+		}
+
+		validators[i] = common.HexToAddress(m.EthereumAddress)
+		powers[i] = new(big.Int).SetUint64(m.Power)
+	}
+
+	initData, err := peggyABI.Pack("initialize", cfg.PeggyID, cfg.PowerThreshold, validators, powers)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to pack initialize call")
+	}
+
+	auth.Context = ctx
+	result := &DeployResult{}
+
+	implAddr, implTx, _, err := wrappers.DeployPeggy(auth, backend)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to deploy Peggy contract")
+	}
+
+	result.PeggyImplementation = implAddr
+	result.TxHashes = append(result.TxHashes, implTx.Hash())
+
+	if _, err := bind.WaitDeployed(ctx, backend, implTx); err != nil {
+		return result, errors.Wrap(err, "Peggy contract deployment failed")
+	}
+
+	adminAddr, adminTx, _, err := proxywrappers.DeployProxyAdmin(auth, backend)
+	if err != nil {
+		return result, errors.Wrap(err, "failed to deploy ProxyAdmin contract")
+	}
+
+	result.ProxyAdmin = adminAddr
+	result.TxHashes = append(result.TxHashes, adminTx.Hash())
+
+	if _, err := bind.WaitDeployed(ctx, backend, adminTx); err != nil {
+		return result, errors.Wrap(err, "ProxyAdmin contract deployment failed")
+	}
+
+	proxyAddr, proxyTx, _, err := proxywrappers.DeployTransparentUpgradeableProxy(auth, backend, implAddr, adminAddr, initData)
+	if err != nil {
+		return result, errors.Wrap(err, "failed to deploy Peggy proxy contract")
+	}
+
+	result.PeggyContract = proxyAddr
+	result.TxHashes = append(result.TxHashes, proxyTx.Hash())
+
+	// a reverted initialize reverts the proxy deployment, so no code is left at the address
+	if _, err := bind.WaitDeployed(ctx, backend, proxyTx); err != nil {
+		return result, errors.Wrap(err, "Peggy proxy contract deployment failed")
+	}
+
+	peggyContract, err := wrappers.NewPeggyCaller(proxyAddr, backend)
+	if err != nil {
+		return result, errors.Wrap(err, "failed to bind Peggy proxy contract")
+	}
+
+	checkpoint, err := peggyContract.StateLastValsetCheckpoint(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return result, errors.Wrap(err, "failed to get valset checkpoint")
+	}
+
+	result.Checkpoint = checkpoint
+
+	// the contract is initialized with a zero nonce and no reward
+	expected := EncodeValsetConfirm(cfg.PeggyID, &types.Valset{
+		Nonce:        0,
+		Members:      cfg.Valset.Members,
+		RewardAmount: sdk.ZeroInt(),
+		RewardToken:  common.Address{}.Hex(),
+	})
+
+	if common.Hash(checkpoint) != expected {
+		return result, errors.Errorf("valset checkpoint mismatch: contract has %s, validators sign %s",
+			common.Hash(checkpoint).Hex(), expected.Hex())
+	}
+
+	if cfg.CosmosToken == nil {
+		return result, nil
+	}
+
+	tokenAddr, tokenTx, _, err := wrappers.DeployCosmosERC20(
+		auth,
+		backend,
+		proxyAddr,
+		cfg.CosmosToken.Name,
+		cfg.CosmosToken.Symbol,
+		cfg.CosmosToken.Decimals,
+	)
+	if err != nil {
+		return result, errors.Wrap(err, "failed to deploy Cosmos token contract")
+	}
+
+	result.CosmosTokenContract = tokenAddr
+	result.TxHashes = append(result.TxHashes, tokenTx.Hash())
+
+	if _, err := bind.WaitDeployed(ctx, backend, tokenTx); err != nil {
+		return result, errors.Wrap(err, "Cosmos token contract deployment failed")
+	}
+
+	return result, nil
+}
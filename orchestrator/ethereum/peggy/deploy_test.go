@@ -0,0 +1,238 @@
+package peggy
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math"
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/InjectiveLabs/peggo/orchestrator/ethereum/keystore"
+	wrappers "github.com/InjectiveLabs/peggo/solidity/wrappers/Peggy.sol"
+	proxywrappers "github.com/InjectiveLabs/peggo/solidity/wrappers/PeggyProxy.sol"
+	"github.com/InjectiveLabs/sdk-go/chain/peggy/types"
+)
+
+// autoCommitBackend mines a block for every sent tx, so the deployment doesn't wait forever.
+type autoCommitBackend struct {
+	*backends.SimulatedBackend
+}
+
+func (b autoCommitBackend) SendTransaction(ctx context.Context, tx *ethtypes.Transaction) error {
+	if err := b.SimulatedBackend.SendTransaction(ctx, tx); err != nil {
+		return err
+	}
+
+	b.Commit()
+	return nil
+}
+
+func newDeployBackend(t *testing.T) (autoCommitBackend, *bind.TransactOpts) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	auth, err := bind.NewKeyedTransactorWithChainID(key, big.NewInt(1337))
+	require.NoError(t, err)
+
+	balance, _ := new(big.Int).SetString("1000000000000000000000", 10)
+	backend := backends.NewSimulatedBackend(core.GenesisAlloc{
+		auth.From: {Balance: balance},
+	}, 30_000_000)
+
+	t.Cleanup(func() { _ = backend.Close() })
+
+	return autoCommitBackend{backend}, auth
+}
+
+func testValset(t *testing.T, size int) *types.Valset {
+	valset, _ := testValsetWithKeys(t, size)
+	return valset
+}
+
+// testValsetWithKeys returns a valset of equally powered members along with their keys, in member order.
+func testValsetWithKeys(t *testing.T, size int) (*types.Valset, []*ecdsa.PrivateKey) {
+	valset := &types.Valset{
+		Nonce:        42,
+		RewardAmount: sdk.ZeroInt(),
+	}
+
+	keys := make([]*ecdsa.PrivateKey, 0, size)
+	for i := 0; i < size; i++ {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+
+		keys = append(keys, key)
+		valset.Members = append(valset.Members, &types.BridgeValidator{
+			EthereumAddress: crypto.PubkeyToAddress(key.PublicKey).Hex(),
+			Power:           math.MaxUint32 / uint64(size),
+		})
+	}
+
+	return valset, keys
+}
+
+// signConfirm signs a checkpoint the way orchestrators confirm valsets and batches.
+func signConfirm(t *testing.T, key *ecdsa.PrivateKey, checkpoint common.Hash) string {
+	signFn, err := keystore.PrivateKeyPersonalSignFn(key)
+	require.NoError(t, err)
+
+	signature, err := signFn(crypto.PubkeyToAddress(key.PublicKey), checkpoint.Bytes())
+	require.NoError(t, err)
+
+	return common.Bytes2Hex(signature)
+}
+
+// testPeggy is a Peggy contract deployed on a simulated backend and bound to a test committer.
+type testPeggy struct {
+	PeggyContract
+
+	committer *testCommitter
+	peggyID   common.Hash
+	// valset is the valset the contract was initialized with
+	valset *types.Valset
+	keys   []*ecdsa.PrivateKey
+}
+
+func deployTestPeggy(t *testing.T, valsetSize int) *testPeggy {
+	backend, auth := newDeployBackend(t)
+
+	var peggyID common.Hash
+	copy(peggyID[:], "injective-peggyid")
+
+	valset, keys := testValsetWithKeys(t, valsetSize)
+	result, err := DeployPeggyContract(context.Background(), backend, auth, DeployConfig{
+		PeggyID:        peggyID,
+		PowerThreshold: big.NewInt(2834678415),
+		Valset:         valset,
+	})
+	require.NoError(t, err)
+
+	// the contract starts from the valset at nonce 0 without a reward
+	valset.Nonce = 0
+	valset.RewardToken = common.Address{}.Hex()
+
+	ethCommitter := &testCommitter{backend: backend, auth: auth}
+	peggyContract, err := NewPeggyContract(ethCommitter, result.PeggyContract, PendingTxInputList{}, 0)
+	require.NoError(t, err)
+
+	return &testPeggy{
+		PeggyContract: peggyContract,
+		committer:     ethCommitter,
+		peggyID:       peggyID,
+		valset:        valset,
+		keys:          keys,
+	}
+}
+
+func TestDeployPeggyContract(t *testing.T) {
+	t.Parallel()
+
+	var peggyID common.Hash
+	copy(peggyID[:], "injective-peggyid")
+	powerThreshold := big.NewInt(2834678415)
+
+	t.Run("deploys Peggy behind a proxy initialized with the Cosmos token", func(t *testing.T) {
+		t.Parallel()
+
+		backend, auth := newDeployBackend(t)
+		valset := testValset(t, 3)
+
+		result, err := DeployPeggyContract(context.Background(), backend, auth, DeployConfig{
+			PeggyID:        peggyID,
+			PowerThreshold: powerThreshold,
+			Valset:         valset,
+			CosmosToken: &CosmosTokenConfig{
+				Name:     "Injective",
+				Symbol:   "INJ",
+				Decimals: 18,
+			},
+		})
+		require.NoError(t, err)
+		assert.Len(t, result.TxHashes, 4)
+
+		proxyAdmin, err := proxywrappers.NewProxyAdminCaller(result.ProxyAdmin, backend)
+		require.NoError(t, err)
+
+		implementation, err := proxyAdmin.GetProxyImplementation(nil, result.PeggyContract)
+		require.NoError(t, err)
+		assert.Equal(t, result.PeggyImplementation, implementation)
+
+		admin, err := proxyAdmin.GetProxyAdmin(nil, result.PeggyContract)
+		require.NoError(t, err)
+		assert.Equal(t, result.ProxyAdmin, admin)
+
+		// EIP-1967 admin slot: keccak256("eip1967.proxy.admin") - 1
+		adminSlot := common.HexToHash("0xb53127684a568b3173ae13b9f8a6016e243e63b6e8ee1178d6a717850b5d6103")
+		storedAdmin, err := backend.StorageAt(context.Background(), result.PeggyContract, adminSlot, nil)
+		require.NoError(t, err)
+		assert.Equal(t, result.ProxyAdmin, common.BytesToAddress(storedAdmin))
+
+		proxyAdminOwner, err := proxyAdmin.Owner(nil)
+		require.NoError(t, err)
+		assert.Equal(t, auth.From, proxyAdminOwner)
+
+		peggyCaller, err := wrappers.NewPeggyCaller(result.PeggyContract, backend)
+		require.NoError(t, err)
+
+		storedPeggyID, err := peggyCaller.StatePeggyId(nil)
+		require.NoError(t, err)
+		assert.Equal(t, peggyID, common.Hash(storedPeggyID))
+
+		storedThreshold, err := peggyCaller.StatePowerThreshold(nil)
+		require.NoError(t, err)
+		assert.Equal(t, powerThreshold.String(), storedThreshold.String())
+
+		owner, err := peggyCaller.Owner(nil)
+		require.NoError(t, err)
+		assert.Equal(t, auth.From, owner)
+
+		// the checkpoint is what the validators sign for the initial valset
+		valset.Nonce = 0
+		valset.RewardToken = common.Address{}.Hex()
+		assert.Equal(t, EncodeValsetConfirm(peggyID, valset), result.Checkpoint)
+
+		token, err := wrappers.NewCosmosERC20Caller(result.CosmosTokenContract, backend)
+		require.NoError(t, err)
+
+		peggyBalance, err := token.BalanceOf(nil, result.PeggyContract)
+		require.NoError(t, err)
+		maxUint256 := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+		assert.Equal(t, maxUint256.String(), peggyBalance.String())
+	})
+
+	t.Run("fails when the valset can't reach the power threshold", func(t *testing.T) {
+		t.Parallel()
+
+		backend, auth := newDeployBackend(t)
+
+		_, err := DeployPeggyContract(context.Background(), backend, auth, DeployConfig{
+			PeggyID:        peggyID,
+			PowerThreshold: big.NewInt(math.MaxUint32),
+			Valset:         testValset(t, 3),
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("fails on an empty valset", func(t *testing.T) {
+		t.Parallel()
+
+		backend, auth := newDeployBackend(t)
+
+		_, err := DeployPeggyContract(context.Background(), backend, auth, DeployConfig{
+			PeggyID:        peggyID,
+			PowerThreshold: powerThreshold,
+			Valset:         &types.Valset{},
+		})
+		assert.Error(t, err)
+	})
+}
@@ -13,9 +13,9 @@ import (
 	"github.com/pkg/errors"
 	"github.com/shopspring/decimal"
 
-	"github.com/InjectiveLabs/metrics"
 	"github.com/InjectiveLabs/peggo/orchestrator/ethereum/committer"
 	"github.com/InjectiveLabs/peggo/orchestrator/ethereum/provider"
+	"github.com/InjectiveLabs/peggo/orchestrator/metrics"
 	wrappers "github.com/InjectiveLabs/peggo/solidity/wrappers/Peggy.sol"
 	"github.com/InjectiveLabs/sdk-go/chain/peggy/types"
 )
@@ -47,6 +47,18 @@ type PeggyContract interface {
 		confirms []*types.MsgValsetConfirm,
 	) (*common.Hash, error)
 
+	EncodeTransactionBatch(
+		currentValset *types.Valset,
+		batch *types.OutgoingTxBatch,
+		confirms []*types.MsgConfirmBatch,
+	) ([]byte, error)
+
+	EncodeValsetUpdate(
+		oldValset *types.Valset,
+		newValset *types.Valset,
+		confirms []*types.MsgValsetConfirm,
+	) ([]byte, error)
+
 	GetTxBatchNonce(
 		ctx context.Context,
 		erc20ContractAddress common.Address,
@@ -58,6 +70,16 @@ type PeggyContract interface {
 		callerAddress common.Address,
 	) (*big.Int, error)
 
+	GetLastEventNonce(
+		ctx context.Context,
+		callerAddress common.Address,
+	) (*big.Int, error)
+
+	GetValsetCheckpoint(
+		ctx context.Context,
+		callerAddress common.Address,
+	) (common.Hash, error)
+
 	GetPeggyID(
 		ctx context.Context,
 		callerAddress common.Address,
@@ -69,6 +91,12 @@ type PeggyContract interface {
 		callerAddress common.Address,
 	) (symbol string, err error)
 
+	GetERC20Decimals(
+		ctx context.Context,
+		erc20ContractAddress common.Address,
+		callerAddress common.Address,
+	) (decimals uint8, err error)
+
 	SubscribeToPendingTxs(
 		alchemyWebsocketURL string)
 }
@@ -86,6 +114,7 @@ func NewPeggyContract(
 
 	svc := &peggyContract{
 		EVMCommitter:          ethCommitter,
+		ethProvider:           ethCommitter.Provider(),
 		peggyAddress:          peggyAddress,
 		ethPeggy:              ethPeggy,
 		pendingTxInputList:    pendingTxInputList,
@@ -151,4 +180,21 @@ func peggyPowerToPercent(total *big.Int) float32 {
 	return float32(f)
 }
 
+// SignedPowerPercent returns the share of the valset power held by the given Ethereum signers, in percent.
+func SignedPowerPercent(valset *types.Valset, signers []string) float32 {
+	signed := make(map[string]bool, len(signers))
+	for _, signer := range signers {
+		signed[signer] = true
+	}
+
+	power := new(big.Int)
+	for _, m := range valset.Members {
+		if signed[m.EthereumAddress] {
+			power.Add(power, new(big.Int).SetUint64(m.Power))
+		}
+	}
+
+	return peggyPowerToPercent(power)
+}
+
 var ErrInsufficientVotingPowerToPass = errors.New("insufficient voting power")
@@ -0,0 +1,48 @@
+package peggy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/InjectiveLabs/sdk-go/chain/peggy/types"
+)
+
+func TestSignedPowerPercent(t *testing.T) {
+	t.Parallel()
+
+	valset := &types.Valset{
+		Members: []*types.BridgeValidator{
+			{EthereumAddress: "0x1111111111111111111111111111111111111111", Power: 2147483648}, // 50%
+			{EthereumAddress: "0x2222222222222222222222222222222222222222", Power: 1431655765}, // 33.3%
+			{EthereumAddress: "0x3333333333333333333333333333333333333333", Power: 715827882},  // 16.7%
+		},
+	}
+
+	for _, tc := range []struct {
+		name    string
+		signers []string
+		percent float32
+	}{
+		{name: "no signers", percent: 0},
+		{name: "every member", signers: []string{
+			"0x1111111111111111111111111111111111111111",
+			"0x2222222222222222222222222222222222222222",
+			"0x3333333333333333333333333333333333333333",
+		}, percent: 100},
+		{name: "part of the members", signers: []string{
+			"0x1111111111111111111111111111111111111111",
+			"0x3333333333333333333333333333333333333333",
+		}, percent: 66.67},
+		{name: "signers outside of the valset are ignored", signers: []string{
+			"0x2222222222222222222222222222222222222222",
+			"0x4444444444444444444444444444444444444444",
+		}, percent: 33.33},
+		{name: "duplicate signers are counted once", signers: []string{
+			"0x1111111111111111111111111111111111111111",
+			"0x1111111111111111111111111111111111111111",
+		}, percent: 50},
+	} {
+		assert.InDelta(t, tc.percent, SignedPowerPercent(valset, tc.signers), 0.01, tc.name)
+	}
+}
@@ -3,17 +3,23 @@ package peggy
 import (
 	"context"
 	"math/big"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
 	log "github.com/xlab/suplog"
 
-	"github.com/InjectiveLabs/metrics"
+	"github.com/InjectiveLabs/peggo/orchestrator/metrics"
 	wrappers "github.com/InjectiveLabs/peggo/solidity/wrappers/Peggy.sol"
 )
 
+// receiptPollInterval is how often the receipt of an approval is polled for.
+var receiptPollInterval = 3 * time.Second
+
 func (s *peggyContract) SendToCosmos(
 	ctx context.Context,
 	erc20 common.Address,
@@ -39,49 +45,95 @@ func (s *peggyContract) SendToCosmos(
 		metrics.ReportFuncError(s.svcTags)
 		err = errors.Wrap(err, "failed to get ERC20 allowance for peggy contract")
 		return nil, err
-	} else if allowance.Cmp(maxUintAllowance) != 0 {
-		// allowance not set or not max (a.k.a. unlocked token)
-		txData, err := erc20ABI.Pack("approve", s.peggyAddress, maxUintAllowance)
-		if err != nil {
-			metrics.ReportFuncError(s.svcTags)
-			log.WithError(err).Errorln("ABI Pack (ERC20 approve) method")
-			return nil, err
+	} else if allowance.Cmp(amount) < 0 {
+		// allowance not set or too low (a.k.a. locked token). Tokens that don't treat
+		// the max allowance as infinite spend it, so it's only renewed when insufficient.
+		// Some tokens (e.g. USDT) revert when changing a non-zero allowance, so it's reset first.
+		if allowance.Sign() > 0 {
+			if err := s.approve(ctx, erc20, big.NewInt(0)); err != nil {
+				metrics.ReportFuncError(s.svcTags)
+				return nil, err
+			}
 		}
 
-		txHash, err := s.SendTx(ctx, erc20, txData)
-		if err != nil {
+		if err := s.approve(ctx, erc20, maxUintAllowance); err != nil {
 			metrics.ReportFuncError(s.svcTags)
-			log.WithError(err).WithField("tx_hash", txHash.Hex()).Errorln("Failed to sign and submit (ERC20 approve) to EVM")
 			return nil, err
 		}
-
-		log.Infoln("Sent Tx (ERC20 approve):", txHash.Hex())
 	}
 
-	// This code deals with some specifics of Ethereum byte encoding, Ethereum is BigEndian
-	// so small values like addresses that don't take up the full length of the byte vector
-	// are pushed up to the top. This duplicates the way Ethereum encodes it's own addresses
-	// as closely as possible.
-	cosmosDestAddressBytes := cosmosAccAddress.Bytes()
-	for len(cosmosDestAddressBytes) < 32 {
-		cosmosDestAddressBytes = append(cosmosDestAddressBytes, byte(0))
-	}
+	// The contract emits the destination as bytes32 and the oracle reads the
+	// account from its last 20 bytes, so the address is left-padded like an
+	// ABI-encoded Ethereum address.
+	destination := cosmosDestination(cosmosAccAddress)
 
-	txData, err := peggyABI.Pack("sendToCosmos", erc20, cosmosDestAddressBytes, amount)
+	txData, err := peggyABI.Pack("sendToInjective", erc20, destination, amount, "")
 	if err != nil {
 		metrics.ReportFuncError(s.svcTags)
-		log.WithError(err).Errorln("ABI Pack (Peggy sendToCosmos) method")
+		log.WithError(err).Errorln("ABI Pack (Peggy sendToInjective) method")
 		return nil, err
 	}
 
 	txHash, err := s.SendTx(ctx, s.peggyAddress, txData)
 	if err != nil {
 		metrics.ReportFuncError(s.svcTags)
-		log.WithError(err).WithField("tx_hash", txHash.Hex()).Errorln("Failed to sign and submit (Peggy sendToCosmos) to EVM")
+		log.WithError(err).WithField("tx_hash", txHash.Hex()).Errorln("Failed to sign and submit (Peggy sendToInjective) to EVM")
 		return nil, err
 	}
 
-	log.Infoln("Sent Tx (Peggy sendToCosmos):", txHash.Hex())
+	log.Infoln("Sent Tx (Peggy sendToInjective):", txHash.Hex())
 
 	return &txHash, nil
 }
+
+// cosmosDestination encodes an Injective account as the bytes32 deposit destination.
+func cosmosDestination(addr sdk.AccAddress) [32]byte {
+	var dest [32]byte
+	copy(dest[12:], addr.Bytes())
+	return dest
+}
+
+// approve sets the allowance of the Peggy contract and waits for the approval to be mined,
+// so that sendToInjective isn't estimated against the old allowance.
+func (s *peggyContract) approve(ctx context.Context, erc20 common.Address, allowance *big.Int) error {
+	txData, err := erc20ABI.Pack("approve", s.peggyAddress, allowance)
+	if err != nil {
+		log.WithError(err).Errorln("ABI Pack (ERC20 approve) method")
+		return err
+	}
+
+	txHash, err := s.SendTx(ctx, erc20, txData)
+	if err != nil {
+		log.WithError(err).WithField("tx_hash", txHash.Hex()).Errorln("Failed to sign and submit (ERC20 approve) to EVM")
+		return err
+	}
+
+	log.WithField("allowance", allowance.String()).Infoln("Sent Tx (ERC20 approve):", txHash.Hex())
+
+	return s.waitMined(ctx, txHash)
+}
+
+// waitMined polls for the receipt of the tx until it's mined, failing if it reverted.
+func (s *peggyContract) waitMined(ctx context.Context, txHash common.Hash) error {
+	ticker := time.NewTicker(receiptPollInterval)
+	defer ticker.Stop()
+
+	for {
+		receipt, err := s.ethProvider.TransactionReceipt(ctx, txHash)
+		if err == nil && receipt != nil {
+			if receipt.Status == ethtypes.ReceiptStatusFailed {
+				return errors.Errorf("tx %s reverted", txHash.Hex())
+			}
+
+			return nil
+		} else if err != nil && err != ethereum.NotFound {
+			log.WithError(err).WithField("tx_hash", txHash.Hex()).Warningln("failed to get tx receipt")
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.Wrapf(ctx.Err(), "tx %s was not mined in time", txHash.Hex())
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,172 @@
+package peggy
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/InjectiveLabs/peggo/orchestrator/ethereum/committer"
+	"github.com/InjectiveLabs/peggo/orchestrator/ethereum/provider"
+	wrappers "github.com/InjectiveLabs/peggo/solidity/wrappers/Peggy.sol"
+	tokenwrappers "github.com/InjectiveLabs/peggo/solidity/wrappers/TestERC20.sol"
+)
+
+// simulatedProvider completes the simulated backend into an EVMProvider.
+type simulatedProvider struct {
+	autoCommitBackend
+}
+
+func (simulatedProvider) FeeHistory(context.Context, uint64, *big.Int, []float64) (*ethereum.FeeHistory, error) {
+	return nil, errors.New("not supported by the simulated backend")
+}
+
+type sentTx struct {
+	recipient common.Address
+	txData    []byte
+}
+
+// testCommitter signs txs with a keyed transactor and records what was sent.
+type testCommitter struct {
+	backend autoCommitBackend
+	auth    *bind.TransactOpts
+	sent    []sentTx
+}
+
+func (c *testCommitter) FromAddress() common.Address { return c.auth.From }
+
+func (c *testCommitter) SenderAddresses() []common.Address { return []common.Address{c.auth.From} }
+
+func (c *testCommitter) Provider() provider.EVMProvider { return simulatedProvider{c.backend} }
+
+func (c *testCommitter) GasPrice(ctx context.Context, _ ...committer.TxOption) (*big.Int, error) {
+	return c.backend.SuggestGasPrice(ctx)
+}
+
+func (c *testCommitter) SendTx(
+	_ context.Context,
+	recipient common.Address,
+	txData []byte,
+	_ ...committer.TxOption,
+) (common.Hash, error) {
+	c.sent = append(c.sent, sentTx{recipient: recipient, txData: txData})
+
+	contract := bind.NewBoundContract(recipient, abi.ABI{}, c.backend, c.backend, c.backend)
+	tx, err := contract.RawTransact(c.auth, txData)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return tx.Hash(), nil
+}
+
+func TestCosmosDestination(t *testing.T) {
+	t.Parallel()
+
+	receiver := sdk.AccAddress(common.HexToAddress("0x4d6b1c6f3c2f6b5e8f1a2b3c4d5e6f708192a3b4").Bytes())
+
+	destination := cosmosDestination(receiver)
+
+	assert.Equal(t, make([]byte, 12), destination[:12])
+	// the oracle reads the receiver of a deposit from the last 20 bytes
+	assert.Equal(t, receiver, sdk.AccAddress(destination[12:32]))
+}
+
+func TestPeggyContract_SendToCosmos(t *testing.T) {
+	t.Parallel()
+
+	receiver := sdk.AccAddress(common.HexToAddress("0x4d6b1c6f3c2f6b5e8f1a2b3c4d5e6f708192a3b4").Bytes())
+	amount := big.NewInt(1000)
+
+	setup := func(t *testing.T) (*testCommitter, PeggyContract, common.Address, *tokenwrappers.TestERC20) {
+		contract := deployTestPeggy(t, 3)
+
+		tokenAddr, _, token, err := tokenwrappers.DeployTestERC20(contract.committer.auth, contract.committer.backend)
+		require.NoError(t, err)
+
+		return contract.committer, contract, tokenAddr, token
+	}
+
+	unpackApprove := func(t *testing.T, tx sentTx) *big.Int {
+		args, err := erc20ABI.Methods["approve"].Inputs.Unpack(tx.txData[4:])
+		require.NoError(t, err)
+		return args[1].(*big.Int)
+	}
+
+	t.Run("resets a partial allowance before approving and deposits to the receiver", func(t *testing.T) {
+		t.Parallel()
+
+		ethCommitter, peggyContract, tokenAddr, token := setup(t)
+
+		_, err := token.Approve(ethCommitter.auth, peggyContract.Address(), big.NewInt(1))
+		require.NoError(t, err)
+
+		txHash, err := peggyContract.SendToCosmos(context.Background(), tokenAddr, amount, receiver, ethCommitter.auth.From)
+		require.NoError(t, err)
+
+		require.Len(t, ethCommitter.sent, 3)
+		assert.Equal(t, tokenAddr, ethCommitter.sent[0].recipient)
+		assert.Equal(t, "0", unpackApprove(t, ethCommitter.sent[0]).String())
+		assert.Equal(t, tokenAddr, ethCommitter.sent[1].recipient)
+		assert.Equal(t, maxUintAllowance.String(), unpackApprove(t, ethCommitter.sent[1]).String())
+		assert.Equal(t, peggyContract.Address(), ethCommitter.sent[2].recipient)
+
+		receipt, err := ethCommitter.backend.TransactionReceipt(context.Background(), *txHash)
+		require.NoError(t, err)
+		require.Equal(t, ethtypes.ReceiptStatusSuccessful, receipt.Status)
+
+		peggyFilterer, err := wrappers.NewPeggyFilterer(peggyContract.Address(), ethCommitter.backend)
+		require.NoError(t, err)
+
+		var deposit *wrappers.PeggySendToInjectiveEvent
+		for _, eventLog := range receipt.Logs {
+			if event, err := peggyFilterer.ParseSendToInjectiveEvent(*eventLog); err == nil {
+				deposit = event
+			}
+		}
+		require.NotNil(t, deposit)
+		assert.Equal(t, amount.String(), deposit.Amount.String())
+		assert.Equal(t, receiver, sdk.AccAddress(deposit.Destination[12:32]))
+
+		peggyBalance, err := token.BalanceOf(nil, peggyContract.Address())
+		require.NoError(t, err)
+		assert.Equal(t, amount.String(), peggyBalance.String())
+	})
+
+	t.Run("approves the max allowance without a reset when none is set", func(t *testing.T) {
+		t.Parallel()
+
+		ethCommitter, peggyContract, tokenAddr, _ := setup(t)
+
+		_, err := peggyContract.SendToCosmos(context.Background(), tokenAddr, amount, receiver, ethCommitter.auth.From)
+		require.NoError(t, err)
+
+		require.Len(t, ethCommitter.sent, 2)
+		assert.Equal(t, maxUintAllowance.String(), unpackApprove(t, ethCommitter.sent[0]).String())
+		assert.Equal(t, peggyContract.Address(), ethCommitter.sent[1].recipient)
+	})
+
+	t.Run("skips the approval when the allowance covers the amount", func(t *testing.T) {
+		t.Parallel()
+
+		ethCommitter, peggyContract, tokenAddr, token := setup(t)
+
+		_, err := token.Approve(ethCommitter.auth, peggyContract.Address(), amount)
+		require.NoError(t, err)
+
+		_, err = peggyContract.SendToCosmos(context.Background(), tokenAddr, amount, receiver, ethCommitter.auth.From)
+		require.NoError(t, err)
+
+		require.Len(t, ethCommitter.sent, 1)
+		assert.Equal(t, peggyContract.Address(), ethCommitter.sent[0].recipient)
+	})
+}
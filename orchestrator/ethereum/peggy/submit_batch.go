@@ -1,14 +1,17 @@
 package peggy
 
 import (
+	"bytes"
 	"context"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	log "github.com/xlab/suplog"
 
-	"github.com/InjectiveLabs/metrics"
+	"github.com/InjectiveLabs/peggo/orchestrator/ethereum/committer"
+	"github.com/InjectiveLabs/peggo/orchestrator/metrics"
 	"github.com/InjectiveLabs/sdk-go/chain/peggy/types"
 )
 
@@ -29,59 +32,9 @@ func (s *peggyContract) SendTransactionBatch(
 		"confirmations":  len(confirms),
 	}).Debugln("checking signatures and submitting batch to Ethereum")
 
-	validators, powers, sigV, sigR, sigS, err := checkBatchSigsAndRepack(currentValset, confirms)
+	txData, err := s.EncodeTransactionBatch(currentValset, batch, confirms)
 	if err != nil {
 		metrics.ReportFuncError(s.svcTags)
-		err = errors.Wrap(err, "confirmations check failed")
-		return nil, err
-	}
-
-	amounts, destinations, fees := getBatchCheckpointValues(batch)
-	currentValsetNonce := new(big.Int).SetUint64(currentValset.Nonce)
-	batchNonce := new(big.Int).SetUint64(batch.BatchNonce)
-	batchTimeout := new(big.Int).SetUint64(batch.BatchTimeout)
-
-	// Solidity function signature
-	// function submitBatch(
-	// 		// The validators that approve the batch and new valset
-	// 		address[] memory _currentValidators,
-	// 		uint256[] memory _currentPowers,
-	// 		uint256 _currentValsetNonce,
-	//
-	// 		// These are arrays of the parts of the validators signatures
-	// 		uint8[] memory _v,
-	// 		bytes32[] memory _r,
-	// 		bytes32[] memory _s,
-	//
-	// 		// The batch of transactions
-	// 		uint256[] memory _amounts,
-	// 		address[] memory _destinations,
-	// 		uint256[] memory _fees,
-	// 		uint256 _batchNonce,
-	// 		address _tokenContract
-	// )
-
-	currentValsetArs := ValsetArgs{
-		Validators:   validators,
-		Powers:       powers,
-		ValsetNonce:  currentValsetNonce,
-		RewardAmount: currentValset.RewardAmount.BigInt(),
-		RewardToken:  common.HexToAddress(currentValset.RewardToken),
-	}
-
-	txData, err := peggyABI.Pack("submitBatch",
-		currentValsetArs,
-		sigV, sigR, sigS,
-		amounts,
-		destinations,
-		fees,
-		batchNonce,
-		common.HexToAddress(batch.TokenContract),
-		batchTimeout,
-	)
-	if err != nil {
-		metrics.ReportFuncError(s.svcTags)
-		log.WithError(err).Errorln("ABI Pack (Peggy submitBatch) method")
 		return nil, err
 	}
 
@@ -90,7 +43,10 @@ func (s *peggyContract) SendTransactionBatch(
 		return nil, errors.New("Transaction with same batch input data is already present in mempool")
 	}
 
-	txHash, err := s.SendTx(ctx, s.peggyAddress, txData)
+	txHash, err := s.SendTx(ctx, s.peggyAddress, txData,
+		committer.WithTxKind(committer.TxKindBatch),
+		committer.WithDeadlineHeight(batch.BatchTimeout),
+	)
 	if err != nil {
 		metrics.ReportFuncError(s.svcTags)
 		log.WithError(err).WithField("tx_hash", txHash.Hex()).Errorln("Failed to sign and submit (Peggy submitBatch) to EVM")
@@ -151,6 +107,70 @@ func (s *peggyContract) SendTransactionBatch(
 	return &txHash, nil
 }
 
+// EncodeTransactionBatch checks the confirmations against the current valset and
+// packs the submitBatch call, without sending it.
+func (s *peggyContract) EncodeTransactionBatch(
+	currentValset *types.Valset,
+	batch *types.OutgoingTxBatch,
+	confirms []*types.MsgConfirmBatch,
+) ([]byte, error) {
+	validators, powers, sigV, sigR, sigS, err := checkBatchSigsAndRepack(currentValset, confirms)
+	if err != nil {
+		err = errors.Wrap(err, "confirmations check failed")
+		return nil, err
+	}
+
+	amounts, destinations, fees := getBatchCheckpointValues(batch)
+	currentValsetNonce := new(big.Int).SetUint64(currentValset.Nonce)
+	batchNonce := new(big.Int).SetUint64(batch.BatchNonce)
+	batchTimeout := new(big.Int).SetUint64(batch.BatchTimeout)
+
+	// Solidity function signature
+	// function submitBatch(
+	// 		// The validators that approve the batch and new valset
+	// 		address[] memory _currentValidators,
+	// 		uint256[] memory _currentPowers,
+	// 		uint256 _currentValsetNonce,
+	//
+	// 		// These are arrays of the parts of the validators signatures
+	// 		uint8[] memory _v,
+	// 		bytes32[] memory _r,
+	// 		bytes32[] memory _s,
+	//
+	// 		// The batch of transactions
+	// 		uint256[] memory _amounts,
+	// 		address[] memory _destinations,
+	// 		uint256[] memory _fees,
+	// 		uint256 _batchNonce,
+	// 		address _tokenContract
+	// )
+
+	currentValsetArs := ValsetArgs{
+		Validators:   validators,
+		Powers:       powers,
+		ValsetNonce:  currentValsetNonce,
+		RewardAmount: currentValset.RewardAmount.BigInt(),
+		RewardToken:  common.HexToAddress(currentValset.RewardToken),
+	}
+
+	txData, err := peggyABI.Pack("submitBatch",
+		currentValsetArs,
+		sigV, sigR, sigS,
+		amounts,
+		destinations,
+		fees,
+		batchNonce,
+		common.HexToAddress(batch.TokenContract),
+		batchTimeout,
+	)
+	if err != nil {
+		log.WithError(err).Errorln("ABI Pack (Peggy submitBatch) method")
+		return nil, err
+	}
+
+	return txData, nil
+}
+
 func getBatchCheckpointValues(batch *types.OutgoingTxBatch) (amounts []*big.Int, destinations []common.Address, fees []*big.Int) {
 	amounts = make([]*big.Int, len(batch.Transactions))
 	destinations = make([]common.Address, len(batch.Transactions))
@@ -215,3 +235,44 @@ func checkBatchSigsAndRepack(
 
 	return
 }
+
+// BatchWithdrawal is a withdrawal paid out by a submitBatch call.
+type BatchWithdrawal struct {
+	Destination common.Address
+	Amount      *big.Int
+	Fee         *big.Int
+}
+
+// DecodeTransactionBatch returns the withdrawals of submitBatch calldata, e.g. the
+// input of a tx that emitted a TransactionBatchExecutedEvent.
+func DecodeTransactionBatch(txData []byte) ([]BatchWithdrawal, error) {
+	submitBatchMethod := peggyABI.Methods["submitBatch"]
+	if len(txData) < 4 || !bytes.Equal(submitBatchMethod.ID, txData[:4]) {
+		return nil, errors.New("not a submitBatch call")
+	}
+
+	args, err := submitBatchMethod.Inputs.Unpack(txData[4:])
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unpack submitBatch args")
+	}
+
+	// args follow the submitBatch signature: valset, v, r, s, amounts, destinations, fees, ...
+	amounts := *abi.ConvertType(args[4], new([]*big.Int)).(*[]*big.Int)
+	destinations := *abi.ConvertType(args[5], new([]common.Address)).(*[]common.Address)
+	fees := *abi.ConvertType(args[6], new([]*big.Int)).(*[]*big.Int)
+
+	if len(amounts) != len(destinations) || len(fees) != len(destinations) {
+		return nil, errors.New("malformed submitBatch args")
+	}
+
+	withdrawals := make([]BatchWithdrawal, len(destinations))
+	for i := range destinations {
+		withdrawals[i] = BatchWithdrawal{
+			Destination: destinations[i],
+			Amount:      amounts[i],
+			Fee:         fees[i],
+		}
+	}
+
+	return withdrawals, nil
+}
@@ -0,0 +1,131 @@
+package peggy
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	wrappers "github.com/InjectiveLabs/peggo/solidity/wrappers/Peggy.sol"
+	tokenwrappers "github.com/InjectiveLabs/peggo/solidity/wrappers/TestERC20.sol"
+	"github.com/InjectiveLabs/sdk-go/chain/peggy/types"
+)
+
+func testBatch(tokenAddr common.Address, receiver common.Address) *types.OutgoingTxBatch {
+	return &types.OutgoingTxBatch{
+		BatchNonce:    1,
+		BatchTimeout:  10000,
+		TokenContract: tokenAddr.Hex(),
+		Transactions: []*types.OutgoingTransferTx{
+			{
+				Id:          1,
+				DestAddress: receiver.Hex(),
+				Erc20Token:  &types.ERC20Token{Contract: tokenAddr.Hex(), Amount: sdk.NewInt(100)},
+				Erc20Fee:    &types.ERC20Token{Contract: tokenAddr.Hex(), Amount: sdk.NewInt(10)},
+			},
+		},
+	}
+}
+
+func confirmBatch(t *testing.T, contract *testPeggy, batch *types.OutgoingTxBatch, signers int) []*types.MsgConfirmBatch {
+	checkpoint := EncodeTxBatchConfirm(contract.peggyID, batch)
+
+	confirms := make([]*types.MsgConfirmBatch, 0, signers)
+	for _, key := range contract.keys[:signers] {
+		confirms = append(confirms, &types.MsgConfirmBatch{
+			Nonce:         batch.BatchNonce,
+			TokenContract: batch.TokenContract,
+			EthSigner:     crypto.PubkeyToAddress(key.PublicKey).Hex(),
+			Signature:     signConfirm(t, key, checkpoint),
+		})
+	}
+
+	return confirms
+}
+
+func TestPeggyContract_EncodeTransactionBatch(t *testing.T) {
+	t.Parallel()
+
+	receiver := common.HexToAddress("0x4d6b1c6f3c2f6b5e8f1a2b3c4d5e6f708192a3b4")
+
+	t.Run("batch confirmed by enough power is executed by the contract", func(t *testing.T) {
+		t.Parallel()
+
+		contract := deployTestPeggy(t, 3)
+		auth, backend := contract.committer.auth, contract.committer.backend
+
+		tokenAddr, _, token, err := tokenwrappers.DeployTestERC20(auth, backend)
+		require.NoError(t, err)
+
+		// the withdrawals are paid from the tokens locked in Peggy
+		_, err = token.Transfer(auth, contract.Address(), big.NewInt(1000))
+		require.NoError(t, err)
+
+		batch := testBatch(tokenAddr, receiver)
+		// 2 of 3 members are above the power threshold, the missing one is packed without a signature
+		confirms := confirmBatch(t, contract, batch, 2)
+
+		txData, err := contract.EncodeTransactionBatch(contract.valset, batch, confirms)
+		require.NoError(t, err)
+
+		withdrawals, err := DecodeTransactionBatch(txData)
+		require.NoError(t, err)
+		require.Len(t, withdrawals, 1)
+		assert.Equal(t, receiver, withdrawals[0].Destination)
+		assert.Equal(t, "100", withdrawals[0].Amount.String())
+		assert.Equal(t, "10", withdrawals[0].Fee.String())
+
+		txHash, err := contract.SendTransactionBatch(context.Background(), contract.valset, batch, confirms)
+		require.NoError(t, err)
+
+		// the calldata sent is the encoded one
+		require.Len(t, contract.committer.sent, 1)
+		assert.Equal(t, txData, contract.committer.sent[0].txData)
+
+		receipt, err := backend.TransactionReceipt(context.Background(), *txHash)
+		require.NoError(t, err)
+		require.Equal(t, ethtypes.ReceiptStatusSuccessful, receipt.Status)
+
+		received, err := token.BalanceOf(nil, receiver)
+		require.NoError(t, err)
+		assert.Equal(t, "100", received.String())
+
+		peggyCaller, err := wrappers.NewPeggyCaller(contract.Address(), backend)
+		require.NoError(t, err)
+
+		batchNonce, err := peggyCaller.LastBatchNonce(nil, tokenAddr)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(1), batchNonce.Uint64())
+	})
+
+	t.Run("confirmations short of the power threshold are rejected", func(t *testing.T) {
+		t.Parallel()
+
+		contract := deployTestPeggy(t, 3)
+		batch := testBatch(common.HexToAddress("0xe28b3B32B6c345A34Ff64674606124Dd5Aceca30"), receiver)
+
+		_, err := contract.EncodeTransactionBatch(contract.valset, batch, confirmBatch(t, contract, batch, 1))
+		assert.Equal(t, ErrInsufficientVotingPowerToPass, errors.Cause(err))
+
+		_, err = contract.SendTransactionBatch(context.Background(), contract.valset, batch, confirmBatch(t, contract, batch, 1))
+		assert.Error(t, err)
+		assert.Empty(t, contract.committer.sent)
+	})
+
+	t.Run("confirmations are required", func(t *testing.T) {
+		t.Parallel()
+
+		contract := deployTestPeggy(t, 3)
+		batch := testBatch(common.HexToAddress("0xe28b3B32B6c345A34Ff64674606124Dd5Aceca30"), receiver)
+
+		_, err := contract.EncodeTransactionBatch(contract.valset, batch, nil)
+		assert.Error(t, err)
+	})
+}
@@ -49,6 +49,44 @@ func (s *peggyContract) GetValsetNonce(
 	return nonce, nil
 }
 
+// Gets the nonce of the last event emitted by the contract
+func (s *peggyContract) GetLastEventNonce(
+	ctx context.Context,
+	callerAddress common.Address,
+) (*big.Int, error) {
+
+	nonce, err := s.ethPeggy.StateLastEventNonce(&bind.CallOpts{
+		From:    callerAddress,
+		Context: ctx,
+	})
+
+	if err != nil {
+		err = errors.Wrap(err, "StateLastEventNonce call failed")
+		return nil, err
+	}
+
+	return nonce, nil
+}
+
+// Gets the checkpoint of the last valset relayed to the contract
+func (s *peggyContract) GetValsetCheckpoint(
+	ctx context.Context,
+	callerAddress common.Address,
+) (common.Hash, error) {
+
+	checkpoint, err := s.ethPeggy.StateLastValsetCheckpoint(&bind.CallOpts{
+		From:    callerAddress,
+		Context: ctx,
+	})
+
+	if err != nil {
+		err = errors.Wrap(err, "StateLastValsetCheckpoint call failed")
+		return common.Hash{}, err
+	}
+
+	return checkpoint, nil
+}
+
 // Gets the peggyID
 func (s *peggyContract) GetPeggyID(
 	ctx context.Context,
@@ -91,3 +129,26 @@ func (s *peggyContract) GetERC20Symbol(
 
 	return symbol, nil
 }
+
+func (s *peggyContract) GetERC20Decimals(
+	ctx context.Context,
+	erc20ContractAddress common.Address,
+	callerAddress common.Address,
+) (decimals uint8, err error) {
+	erc20Wrapper := bind.NewBoundContract(erc20ContractAddress, erc20ABI, s.ethProvider, nil, nil)
+
+	callOpts := &bind.CallOpts{
+		From:    callerAddress,
+		Context: ctx,
+	}
+	var out []interface{}
+	err = erc20Wrapper.Call(callOpts, &out, "decimals")
+	if err != nil {
+		err = errors.Wrap(err, "ERC20 [decimals] call failed")
+		return 0, err
+	}
+
+	decimals = *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return decimals, nil
+}
@@ -8,7 +8,8 @@ import (
 	"github.com/pkg/errors"
 	log "github.com/xlab/suplog"
 
-	"github.com/InjectiveLabs/metrics"
+	"github.com/InjectiveLabs/peggo/orchestrator/ethereum/committer"
+	"github.com/InjectiveLabs/peggo/orchestrator/metrics"
 	"github.com/InjectiveLabs/sdk-go/chain/peggy/types"
 )
 
@@ -42,61 +43,9 @@ func (s *peggyContract) SendEthValsetUpdate(
 		"new_nonce": newValset.Nonce,
 	}).Infoln("Checking signatures and submitting validator set update to Ethereum")
 
-	newValidators, newPowers := validatorsAndPowers(newValset)
-	newValsetNonce := new(big.Int).SetUint64(newValset.Nonce)
-
-	newValsetArgs := ValsetArgs{
-		Validators:   newValidators,
-		Powers:       newPowers,
-		ValsetNonce:  newValsetNonce,
-		RewardAmount: newValset.RewardAmount.BigInt(),
-		RewardToken:  common.HexToAddress(newValset.RewardToken),
-	}
-
-	// we need to use the old valset here because our signatures need to match the current
-	// members of the validator set in the contract.
-	currentValidators, currentPowers, sigV, sigR, sigS, err := checkValsetSigsAndRepack(oldValset, confirms)
+	txData, err := s.EncodeValsetUpdate(oldValset, newValset, confirms)
 	if err != nil {
 		metrics.ReportFuncError(s.svcTags)
-		err = errors.Wrap(err, "confirmations check failed")
-		return nil, err
-	}
-	currentValsetNonce := new(big.Int).SetUint64(oldValset.Nonce)
-	currentValsetArgs := ValsetArgs{
-		Validators:   currentValidators,
-		Powers:       currentPowers,
-		ValsetNonce:  currentValsetNonce,
-		RewardAmount: oldValset.RewardAmount.BigInt(),
-		RewardToken:  common.HexToAddress(oldValset.RewardToken),
-	}
-	// Solidity function signature
-	// function updateValset(
-	// 		// The new version of the validator set
-	// 		address[] memory _newValidators,
-	// 		uint256[] memory _newPowers,
-	// 		uint256 _newValsetNonce,
-	//
-	// 		// The current validators that approve the change
-	// 		address[] memory _currentValidators,
-	// 		uint256[] memory _currentPowers,
-	// 		uint256 _currentValsetNonce,
-	//
-	// 		// These are arrays of the parts of the current validator's signatures
-	// 		uint8[] memory _v,
-	// 		bytes32[] memory _r,
-	// 		bytes32[] memory _s
-	// )
-	log.Debugln("Sending updateValset Ethereum tx", "currentValidators", currentValidators, "currentPowers", currentPowers, "currentValsetNonce", currentValsetNonce)
-	txData, err := peggyABI.Pack("updateValset",
-		newValsetArgs,
-		currentValsetArgs,
-		sigV,
-		sigR,
-		sigS,
-	)
-	if err != nil {
-		metrics.ReportFuncError(s.svcTags)
-		log.WithError(err).Errorln("ABI Pack (Peggy updateValset) method")
 		return nil, err
 	}
 
@@ -105,7 +54,7 @@ func (s *peggyContract) SendEthValsetUpdate(
 		return nil, errors.New("Transaction with same valset input data is already present in mempool")
 	}
 
-	txHash, err := s.SendTx(ctx, s.peggyAddress, txData)
+	txHash, err := s.SendTx(ctx, s.peggyAddress, txData, committer.WithTxKind(committer.TxKindValsetUpdate))
 	if err != nil {
 		metrics.ReportFuncError(s.svcTags)
 		log.WithError(err).WithField("tx_hash", txHash.Hex()).Errorln("Failed to sign and submit (Peggy updateValset) to EVM")
@@ -159,6 +108,72 @@ func (s *peggyContract) SendEthValsetUpdate(
 	return &txHash, nil
 }
 
+// EncodeValsetUpdate checks the confirmations against the old valset and
+// packs the updateValset call, without sending it.
+func (s *peggyContract) EncodeValsetUpdate(
+	oldValset *types.Valset,
+	newValset *types.Valset,
+	confirms []*types.MsgValsetConfirm,
+) ([]byte, error) {
+	newValidators, newPowers := validatorsAndPowers(newValset)
+	newValsetNonce := new(big.Int).SetUint64(newValset.Nonce)
+
+	newValsetArgs := ValsetArgs{
+		Validators:   newValidators,
+		Powers:       newPowers,
+		ValsetNonce:  newValsetNonce,
+		RewardAmount: newValset.RewardAmount.BigInt(),
+		RewardToken:  common.HexToAddress(newValset.RewardToken),
+	}
+
+	// we need to use the old valset here because our signatures need to match the current
+	// members of the validator set in the contract.
+	currentValidators, currentPowers, sigV, sigR, sigS, err := checkValsetSigsAndRepack(oldValset, confirms)
+	if err != nil {
+		err = errors.Wrap(err, "confirmations check failed")
+		return nil, err
+	}
+	currentValsetNonce := new(big.Int).SetUint64(oldValset.Nonce)
+	currentValsetArgs := ValsetArgs{
+		Validators:   currentValidators,
+		Powers:       currentPowers,
+		ValsetNonce:  currentValsetNonce,
+		RewardAmount: oldValset.RewardAmount.BigInt(),
+		RewardToken:  common.HexToAddress(oldValset.RewardToken),
+	}
+	// Solidity function signature
+	// function updateValset(
+	// 		// The new version of the validator set
+	// 		address[] memory _newValidators,
+	// 		uint256[] memory _newPowers,
+	// 		uint256 _newValsetNonce,
+	//
+	// 		// The current validators that approve the change
+	// 		address[] memory _currentValidators,
+	// 		uint256[] memory _currentPowers,
+	// 		uint256 _currentValsetNonce,
+	//
+	// 		// These are arrays of the parts of the current validator's signatures
+	// 		uint8[] memory _v,
+	// 		bytes32[] memory _r,
+	// 		bytes32[] memory _s
+	// )
+	log.Debugln("Sending updateValset Ethereum tx", "currentValidators", currentValidators, "currentPowers", currentPowers, "currentValsetNonce", currentValsetNonce)
+	txData, err := peggyABI.Pack("updateValset",
+		newValsetArgs,
+		currentValsetArgs,
+		sigV,
+		sigR,
+		sigS,
+	)
+	if err != nil {
+		log.WithError(err).Errorln("ABI Pack (Peggy updateValset) method")
+		return nil, err
+	}
+
+	return txData, nil
+}
+
 func validatorsAndPowers(valset *types.Valset) (
 	validators []common.Address,
 	powers []*big.Int,
@@ -0,0 +1,102 @@
+package peggy
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	wrappers "github.com/InjectiveLabs/peggo/solidity/wrappers/Peggy.sol"
+	"github.com/InjectiveLabs/sdk-go/chain/peggy/types"
+)
+
+func confirmValset(t *testing.T, contract *testPeggy, valset *types.Valset, signers int) []*types.MsgValsetConfirm {
+	checkpoint := EncodeValsetConfirm(contract.peggyID, valset)
+
+	confirms := make([]*types.MsgValsetConfirm, 0, signers)
+	for _, key := range contract.keys[:signers] {
+		confirms = append(confirms, &types.MsgValsetConfirm{
+			Nonce:      valset.Nonce,
+			EthAddress: crypto.PubkeyToAddress(key.PublicKey).Hex(),
+			Signature:  signConfirm(t, key, checkpoint),
+		})
+	}
+
+	return confirms
+}
+
+func TestPeggyContract_EncodeValsetUpdate(t *testing.T) {
+	t.Parallel()
+
+	newTestValset := func(t *testing.T) *types.Valset {
+		valset := testValset(t, 4)
+		valset.Nonce = 1
+		valset.RewardAmount = sdk.ZeroInt()
+		valset.RewardToken = common.Address{}.Hex()
+
+		return valset
+	}
+
+	t.Run("update confirmed by enough power is accepted by the contract", func(t *testing.T) {
+		t.Parallel()
+
+		contract := deployTestPeggy(t, 3)
+		newValset := newTestValset(t)
+		// 2 of 3 members are above the power threshold, the missing one is packed without a signature
+		confirms := confirmValset(t, contract, newValset, 2)
+
+		txData, err := contract.EncodeValsetUpdate(contract.valset, newValset, confirms)
+		require.NoError(t, err)
+
+		txHash, err := contract.SendEthValsetUpdate(context.Background(), contract.valset, newValset, confirms)
+		require.NoError(t, err)
+
+		// the calldata sent is the encoded one
+		require.Len(t, contract.committer.sent, 1)
+		assert.Equal(t, txData, contract.committer.sent[0].txData)
+
+		receipt, err := contract.committer.backend.TransactionReceipt(context.Background(), *txHash)
+		require.NoError(t, err)
+		require.Equal(t, ethtypes.ReceiptStatusSuccessful, receipt.Status)
+
+		peggyCaller, err := wrappers.NewPeggyCaller(contract.Address(), contract.committer.backend)
+		require.NoError(t, err)
+
+		valsetNonce, err := peggyCaller.StateLastValsetNonce(nil)
+		require.NoError(t, err)
+		assert.Equal(t, newValset.Nonce, valsetNonce.Uint64())
+
+		checkpoint, err := peggyCaller.StateLastValsetCheckpoint(nil)
+		require.NoError(t, err)
+		assert.Equal(t, EncodeValsetConfirm(contract.peggyID, newValset), common.Hash(checkpoint))
+	})
+
+	t.Run("confirmations short of the power threshold are rejected", func(t *testing.T) {
+		t.Parallel()
+
+		contract := deployTestPeggy(t, 3)
+		newValset := newTestValset(t)
+
+		_, err := contract.EncodeValsetUpdate(contract.valset, newValset, confirmValset(t, contract, newValset, 1))
+		assert.Equal(t, ErrInsufficientVotingPowerToPass, errors.Cause(err))
+
+		_, err = contract.SendEthValsetUpdate(context.Background(), contract.valset, newValset, confirmValset(t, contract, newValset, 1))
+		assert.Error(t, err)
+		assert.Empty(t, contract.committer.sent)
+	})
+
+	t.Run("confirmations are required", func(t *testing.T) {
+		t.Parallel()
+
+		contract := deployTestPeggy(t, 3)
+
+		_, err := contract.EncodeValsetUpdate(contract.valset, newTestValset(t), nil)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,179 @@
+package util
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+// NonceRecord is a nonce that has been used to broadcast a transaction.
+type NonceRecord struct {
+	Nonce    uint64      `json:"nonce"`
+	TxHash   common.Hash `json:"tx_hash"`
+	GasPrice *big.Int    `json:"gas_price"`
+	SentAt   time.Time   `json:"sent_at"`
+}
+
+// NonceJournal keeps track of every nonce used by sender accounts along with the tx hash,
+// so that pending transactions and nonce gaps can be recovered after a restart.
+type NonceJournal interface {
+	// Record stores a broadcast transaction, overriding any previous record for the same nonce.
+	Record(account common.Address, rec NonceRecord) error
+	// Records returns unconfirmed records of the account, sorted by nonce.
+	Records(account common.Address) []NonceRecord
+	// Prune forgets all records with nonces below the confirmed nonce.
+	Prune(account common.Address, confirmedNonce uint64) error
+}
+
+// NewNonceJournal loads a journal from the file at path, creating it if needed.
+// An empty path returns a journal that is kept in memory only.
+func NewNonceJournal(path string) (NonceJournal, error) {
+	j := &nonceJournal{
+		path:    path,
+		records: make(map[common.Address][]NonceRecord),
+	}
+
+	if len(path) == 0 {
+		return j, nil
+	}
+
+	records, err := ReadNonceJournal(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if records == nil {
+		if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+			err = errors.Wrap(err, "failed to create nonce journal dir")
+			return nil, err
+		}
+
+		return j, nil
+	}
+
+	j.records = records
+
+	return j, nil
+}
+
+// ReadNonceJournal returns the records of the journal file at path without creating
+// anything on disk, nil if the file doesn't exist.
+func ReadNonceJournal(path string) (map[common.Address][]NonceRecord, error) {
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		err = errors.Wrap(err, "failed to read nonce journal")
+		return nil, err
+	}
+
+	records := make(map[common.Address][]NonceRecord)
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &records); err != nil {
+			err = errors.Wrapf(err, "failed to parse nonce journal %s", path)
+			return nil, err
+		}
+	}
+
+	return records, nil
+}
+
+type nonceJournal struct {
+	path string
+
+	mux     sync.Mutex
+	records map[common.Address][]NonceRecord
+}
+
+func (j *nonceJournal) Record(account common.Address, rec NonceRecord) error {
+	j.mux.Lock()
+	defer j.mux.Unlock()
+
+	records := j.records[account]
+	replaced := false
+	for i := range records {
+		if records[i].Nonce == rec.Nonce {
+			records[i] = rec
+			replaced = true
+			break
+		}
+	}
+
+	if !replaced {
+		records = append(records, rec)
+		sort.Slice(records, func(i, k int) bool { return records[i].Nonce < records[k].Nonce })
+	}
+
+	j.records[account] = records
+
+	return j.flush()
+}
+
+func (j *nonceJournal) Records(account common.Address) []NonceRecord {
+	j.mux.Lock()
+	defer j.mux.Unlock()
+
+	records := make([]NonceRecord, len(j.records[account]))
+	copy(records, j.records[account])
+
+	return records
+}
+
+func (j *nonceJournal) Prune(account common.Address, confirmedNonce uint64) error {
+	j.mux.Lock()
+	defer j.mux.Unlock()
+
+	records := j.records[account]
+	pruned := records[:0]
+	for _, rec := range records {
+		if rec.Nonce >= confirmedNonce {
+			pruned = append(pruned, rec)
+		}
+	}
+
+	if len(pruned) == len(records) {
+		return nil
+	}
+
+	if len(pruned) == 0 {
+		delete(j.records, account)
+	} else {
+		j.records[account] = pruned
+	}
+
+	return j.flush()
+}
+
+// flush atomically writes the journal to disk, must be called with mux held.
+func (j *nonceJournal) flush() error {
+	if len(j.path) == 0 {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(j.records, "", "  ")
+	if err != nil {
+		err = errors.Wrap(err, "failed to encode nonce journal")
+		return err
+	}
+
+	tmpPath := j.path + ".tmp"
+	if err := ioutil.WriteFile(tmpPath, data, 0600); err != nil {
+		err = errors.Wrap(err, "failed to write nonce journal")
+		return err
+	}
+
+	if err := os.Rename(tmpPath, j.path); err != nil {
+		err = errors.Wrap(err, "failed to replace nonce journal")
+		return err
+	}
+
+	return nil
+}
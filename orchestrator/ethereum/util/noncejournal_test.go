@@ -0,0 +1,75 @@
+package util
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNonceJournal(t *testing.T) {
+	t.Parallel()
+
+	account := common.HexToAddress("0x76d2dDbb89C36FA39FAa5c5e7C61ee95AC4D76C4")
+	path := filepath.Join(t.TempDir(), "nonces.json")
+
+	journal, err := NewNonceJournal(path)
+	assert.NoError(t, err)
+
+	for _, nonce := range []uint64{7, 5, 6} {
+		err := journal.Record(account, NonceRecord{
+			Nonce:    nonce,
+			TxHash:   common.BigToHash(big.NewInt(int64(nonce))),
+			GasPrice: big.NewInt(1e9),
+			SentAt:   time.Now(),
+		})
+		assert.NoError(t, err)
+	}
+
+	// replacing a nonce overrides the record
+	assert.NoError(t, journal.Record(account, NonceRecord{Nonce: 6, TxHash: common.HexToHash("0x66")}))
+
+	// records survive a restart
+	reloaded, err := NewNonceJournal(path)
+	assert.NoError(t, err)
+
+	records := reloaded.Records(account)
+	assert.Len(t, records, 3)
+	assert.Equal(t, uint64(5), records[0].Nonce)
+	assert.Equal(t, common.HexToHash("0x66"), records[1].TxHash)
+
+	assert.NoError(t, reloaded.Prune(account, 7))
+
+	records = reloaded.Records(account)
+	assert.Len(t, records, 1)
+	assert.Equal(t, uint64(7), records[0].Nonce)
+}
+
+func TestReadNonceJournal(t *testing.T) {
+	t.Parallel()
+
+	account := common.HexToAddress("0x76d2dDbb89C36FA39FAa5c5e7C61ee95AC4D76C4")
+	dir := filepath.Join(t.TempDir(), "journal")
+	path := filepath.Join(dir, "nonces.json")
+
+	// a missing journal is not created
+	records, err := ReadNonceJournal(path)
+	assert.NoError(t, err)
+	assert.Nil(t, records)
+
+	_, err = os.Stat(dir)
+	assert.True(t, os.IsNotExist(err))
+
+	journal, err := NewNonceJournal(path)
+	assert.NoError(t, err)
+	assert.NoError(t, journal.Record(account, NonceRecord{Nonce: 5, TxHash: common.HexToHash("0x55")}))
+
+	records, err = ReadNonceJournal(path)
+	assert.NoError(t, err)
+	assert.Len(t, records[account], 1)
+	assert.Equal(t, common.HexToHash("0x55"), records[account][0].TxHash)
+}
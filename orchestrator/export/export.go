@@ -0,0 +1,441 @@
+package export
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"time"
+
+	cosmtypes "github.com/cosmos/cosmos-sdk/types"
+	eth "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	log "github.com/xlab/suplog"
+
+	"github.com/InjectiveLabs/peggo/orchestrator/ethereum/peggy"
+	wrappers "github.com/InjectiveLabs/peggo/solidity/wrappers/Peggy.sol"
+	peggytypes "github.com/InjectiveLabs/sdk-go/chain/peggy/types"
+)
+
+// BlocksPerScan is the block range of a single events query, same as the one of the Ethereum oracle.
+const BlocksPerScan uint64 = 2000
+
+const (
+	KindDeposit    = "deposit"
+	KindWithdrawal = "withdrawal"
+
+	// StatusObserved deposits are attested by the validators and minted on Injective.
+	StatusObserved = "observed"
+	// StatusPending deposits are waiting for the validators to attest them, Export holds them back.
+	StatusPending = "pending"
+	// StatusExecuted withdrawals are paid out on Ethereum.
+	StatusExecuted = "executed"
+)
+
+// Record is a single bridge transfer.
+type Record struct {
+	Kind       string    `json:"kind"`
+	Status     string    `json:"status"`
+	EventNonce uint64    `json:"event_nonce"`
+	BatchNonce uint64    `json:"batch_nonce,omitempty"`
+	TransferID uint64    `json:"transfer_id,omitempty"`
+	Block      uint64    `json:"block"`
+	Timestamp  time.Time `json:"timestamp"`
+	TxHash     string    `json:"tx_hash"`
+	Token      string    `json:"token"`
+	Symbol     string    `json:"symbol"`
+	Amount     string    `json:"amount"`
+	Fee        string    `json:"fee,omitempty"`
+	Sender     string    `json:"sender"`
+	Receiver   string    `json:"receiver"`
+
+	logIndex uint
+}
+
+// EthereumSource is the part of ethereum.Network the exporter reads from.
+type EthereumSource interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*ethtypes.Header, error)
+	TransactionByHash(ctx context.Context, txHash eth.Hash) (*ethtypes.Transaction, bool, error)
+	TokenDecimals(ctx context.Context, tokenAddr eth.Address) (uint8, error)
+	TokenSymbol(ctx context.Context, tokenAddr eth.Address) (string, error)
+
+	GetSendToCosmosEvents(startBlock, endBlock uint64) ([]*wrappers.PeggySendToCosmosEvent, error)
+	GetSendToInjectiveEvents(startBlock, endBlock uint64) ([]*wrappers.PeggySendToInjectiveEvent, error)
+	GetTransactionBatchExecutedEvents(startBlock, endBlock uint64) ([]*wrappers.PeggyTransactionBatchExecutedEvent, error)
+}
+
+// InjectiveSource is the part of the Peggy module queries the exporter reads from.
+type InjectiveSource interface {
+	LastObservedEventNonce(ctx context.Context) (uint64, error)
+	LatestTransactionBatches(ctx context.Context) ([]*peggytypes.OutgoingTxBatch, error)
+}
+
+// Exporter turns Peggy contract events into bridge transfer records.
+type Exporter struct {
+	ethereum  EthereumSource
+	injective InjectiveSource
+	log       log.Logger
+
+	symbols    map[eth.Address]string
+	timestamps map[uint64]time.Time
+}
+
+func NewExporter(ethereum EthereumSource, injective InjectiveSource) *Exporter {
+	return &Exporter{
+		ethereum:   ethereum,
+		injective:  injective,
+		log:        log.WithField("svc", "export"),
+		symbols:    make(map[eth.Address]string),
+		timestamps: make(map[uint64]time.Time),
+	}
+}
+
+// Export writes the records of blocks from..to in chunks of BlocksPerScan. After each
+// chunk is written, done is called with its last block, so the progress can be saved.
+// Records are append-only, so the export stops before the block of the first deposit
+// the validators haven't attested yet; the next run picks it up once it's observed.
+func (e *Exporter) Export(ctx context.Context, from, to uint64, w Writer, done func(lastBlock uint64) error) error {
+	for start := from; start <= to; start += BlocksPerScan {
+		end := start + BlocksPerScan - 1
+		if end > to {
+			end = to
+		}
+
+		records, err := e.Records(ctx, start, end)
+		if err != nil {
+			return err
+		}
+
+		pendingBlock, hasPending := firstPendingBlock(records)
+		if hasPending {
+			if pendingBlock == start {
+				e.log.WithField("block", pendingBlock).Infoln("holding back export until the pending deposit is observed")
+				return nil
+			}
+
+			records = recordsBefore(records, pendingBlock)
+			end = pendingBlock - 1
+		}
+
+		for _, rec := range records {
+			if err := w.Write(rec); err != nil {
+				return errors.Wrap(err, "failed to write record")
+			}
+		}
+
+		if err := w.Flush(); err != nil {
+			return errors.Wrap(err, "failed to flush records")
+		}
+
+		e.log.WithFields(log.Fields{
+			"from":    start,
+			"to":      end,
+			"records": len(records),
+		}).Infoln("exported blocks")
+
+		if err := done(end); err != nil {
+			return err
+		}
+
+		if hasPending {
+			e.log.WithField("block", pendingBlock).Infoln("holding back export until the pending deposit is observed")
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// firstPendingBlock returns the block of the first deposit that isn't observed yet.
+func firstPendingBlock(records []Record) (uint64, bool) {
+	for _, rec := range records {
+		if rec.Kind == KindDeposit && rec.Status == StatusPending {
+			return rec.Block, true
+		}
+	}
+
+	return 0, false
+}
+
+// recordsBefore returns the records of blocks before block, records are sorted by block.
+func recordsBefore(records []Record, block uint64) []Record {
+	for i, rec := range records {
+		if rec.Block >= block {
+			return records[:i]
+		}
+	}
+
+	return records
+}
+
+// Records returns the deposits and withdrawals of blocks from..to, in the order they happened.
+func (e *Exporter) Records(ctx context.Context, from, to uint64) ([]Record, error) {
+	deposits, err := e.deposits(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	withdrawals, err := e.withdrawals(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	records := append(deposits, withdrawals...)
+	sort.SliceStable(records, func(i, j int) bool {
+		if records[i].Block != records[j].Block {
+			return records[i].Block < records[j].Block
+		}
+
+		return records[i].logIndex < records[j].logIndex
+	})
+
+	return records, nil
+}
+
+func (e *Exporter) deposits(ctx context.Context, from, to uint64) ([]Record, error) {
+	legacyDeposits, err := e.ethereum.GetSendToCosmosEvents(from, to)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get SendToCosmos events")
+	}
+
+	deposits, err := e.ethereum.GetSendToInjectiveEvents(from, to)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get SendToInjective events")
+	}
+
+	if len(legacyDeposits) == 0 && len(deposits) == 0 {
+		return nil, nil
+	}
+
+	observedNonce, err := e.injective.LastObservedEventNonce(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get last observed event nonce")
+	}
+
+	var records []Record
+
+	for _, ev := range legacyDeposits {
+		rec, err := e.depositRecord(ctx, ev.Raw, ev.TokenContract, ev.Sender, ev.Destination, ev.Amount, ev.EventNonce, observedNonce)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, rec)
+	}
+
+	for _, ev := range deposits {
+		rec, err := e.depositRecord(ctx, ev.Raw, ev.TokenContract, ev.Sender, ev.Destination, ev.Amount, ev.EventNonce, observedNonce)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, rec)
+	}
+
+	return records, nil
+}
+
+func (e *Exporter) depositRecord(
+	ctx context.Context,
+	raw ethtypes.Log,
+	tokenAddr eth.Address,
+	sender eth.Address,
+	destination [32]byte,
+	amount *big.Int,
+	eventNonce *big.Int,
+	observedNonce uint64,
+) (Record, error) {
+	rec := Record{
+		Kind:       KindDeposit,
+		Status:     StatusPending,
+		EventNonce: eventNonce.Uint64(),
+		Sender:     sender.Hex(),
+		Receiver:   cosmtypes.AccAddress(destination[12:32]).String(),
+	}
+
+	if rec.EventNonce <= observedNonce {
+		rec.Status = StatusObserved
+	}
+
+	if err := e.fillEventFields(ctx, &rec, raw, tokenAddr); err != nil {
+		return Record{}, err
+	}
+
+	decimals, err := e.ethereum.TokenDecimals(ctx, tokenAddr)
+	if err != nil {
+		return Record{}, err
+	}
+
+	rec.Amount = formatAmount(amount, decimals)
+
+	return rec, nil
+}
+
+func (e *Exporter) withdrawals(ctx context.Context, from, to uint64) ([]Record, error) {
+	executedBatches, err := e.ethereum.GetTransactionBatchExecutedEvents(from, to)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get TransactionBatchExecuted events")
+	}
+
+	if len(executedBatches) == 0 {
+		return nil, nil
+	}
+
+	// batches stay on Injective until their execution is observed, older ones are read from the tx calldata
+	injBatches, err := e.injective.LatestTransactionBatches(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get outgoing batches")
+	}
+
+	var records []Record
+
+	for _, ev := range executedBatches {
+		decimals, err := e.ethereum.TokenDecimals(ctx, ev.Token)
+		if err != nil {
+			return nil, err
+		}
+
+		base := Record{
+			Kind:       KindWithdrawal,
+			Status:     StatusExecuted,
+			EventNonce: ev.EventNonce.Uint64(),
+			BatchNonce: ev.BatchNonce.Uint64(),
+		}
+
+		if err := e.fillEventFields(ctx, &base, ev.Raw, ev.Token); err != nil {
+			return nil, err
+		}
+
+		if batch := findBatch(injBatches, ev.Token, base.BatchNonce); batch != nil {
+			for _, tx := range batch.Transactions {
+				rec := base
+				rec.TransferID = tx.Id
+				rec.Sender = tx.Sender
+				rec.Receiver = eth.HexToAddress(tx.DestAddress).Hex()
+				rec.Amount = formatAmount(tx.Erc20Token.Amount.BigInt(), decimals)
+				rec.Fee = formatAmount(tx.Erc20Fee.Amount.BigInt(), decimals)
+				records = append(records, rec)
+			}
+
+			continue
+		}
+
+		batchWithdrawals, err := e.decodeBatchTx(ctx, ev.Raw.TxHash)
+		if err != nil {
+			// the batch might have been submitted through another contract, keep the batch itself
+			e.log.WithError(err).WithField("tx_hash", ev.Raw.TxHash.Hex()).Warningln("unable to decode batch withdrawals")
+			records = append(records, base)
+			continue
+		}
+
+		for _, withdrawal := range batchWithdrawals {
+			rec := base
+			rec.Receiver = withdrawal.Destination.Hex()
+			rec.Amount = formatAmount(withdrawal.Amount, decimals)
+			rec.Fee = formatAmount(withdrawal.Fee, decimals)
+			records = append(records, rec)
+		}
+	}
+
+	return records, nil
+}
+
+func (e *Exporter) decodeBatchTx(ctx context.Context, txHash eth.Hash) ([]peggy.BatchWithdrawal, error) {
+	tx, _, err := e.ethereum.TransactionByHash(ctx, txHash)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get batch tx")
+	}
+
+	return peggy.DecodeTransactionBatch(tx.Data())
+}
+
+// fillEventFields sets the fields every record has from the event log.
+func (e *Exporter) fillEventFields(ctx context.Context, rec *Record, raw ethtypes.Log, tokenAddr eth.Address) error {
+	timestamp, err := e.blockTime(ctx, raw.BlockNumber)
+	if err != nil {
+		return err
+	}
+
+	symbol, err := e.tokenSymbol(ctx, tokenAddr)
+	if err != nil {
+		return err
+	}
+
+	rec.Block = raw.BlockNumber
+	rec.Timestamp = timestamp
+	rec.TxHash = raw.TxHash.Hex()
+	rec.Token = tokenAddr.Hex()
+	rec.Symbol = symbol
+	rec.logIndex = raw.Index
+
+	return nil
+}
+
+func (e *Exporter) blockTime(ctx context.Context, block uint64) (time.Time, error) {
+	if timestamp, ok := e.timestamps[block]; ok {
+		return timestamp, nil
+	}
+
+	header, err := e.ethereum.HeaderByNumber(ctx, new(big.Int).SetUint64(block))
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "failed to get header of block %d", block)
+	}
+
+	timestamp := time.Unix(int64(header.Time), 0).UTC()
+	e.timestamps[block] = timestamp
+
+	return timestamp, nil
+}
+
+func (e *Exporter) tokenSymbol(ctx context.Context, tokenAddr eth.Address) (string, error) {
+	if symbol, ok := e.symbols[tokenAddr]; ok {
+		return symbol, nil
+	}
+
+	symbol, err := e.ethereum.TokenSymbol(ctx, tokenAddr)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get symbol of token %s", tokenAddr.Hex())
+	}
+
+	e.symbols[tokenAddr] = symbol
+
+	return symbol, nil
+}
+
+func findBatch(batches []*peggytypes.OutgoingTxBatch, tokenAddr eth.Address, batchNonce uint64) *peggytypes.OutgoingTxBatch {
+	for _, batch := range batches {
+		if batch.BatchNonce == batchNonce && eth.HexToAddress(batch.TokenContract) == tokenAddr {
+			return batch
+		}
+	}
+
+	return nil
+}
+
+// formatAmount converts raw token units to whole tokens.
+func formatAmount(amount *big.Int, decimals uint8) string {
+	return decimal.NewFromBigInt(amount, -int32(decimals)).String()
+}
+
+// BlockAtTime returns the first block in lo..hi mined at or after t, or hi+1 if there is none.
+func BlockAtTime(ctx context.Context, ethereum EthereumSource, t time.Time, lo, hi uint64) (uint64, error) {
+	end := hi + 1
+
+	for lo < end {
+		mid := lo + (end-lo)/2
+
+		header, err := ethereum.HeaderByNumber(ctx, new(big.Int).SetUint64(mid))
+		if err != nil {
+			return 0, errors.Wrapf(err, "failed to get header of block %d", mid)
+		}
+
+		if int64(header.Time) < t.Unix() {
+			lo = mid + 1
+		} else {
+			end = mid
+		}
+	}
+
+	return lo, nil
+}
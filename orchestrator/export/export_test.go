@@ -0,0 +1,304 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	cosmtypes "github.com/cosmos/cosmos-sdk/types"
+	eth "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	wrappers "github.com/InjectiveLabs/peggo/solidity/wrappers/Peggy.sol"
+	peggytypes "github.com/InjectiveLabs/sdk-go/chain/peggy/types"
+)
+
+type mockEthereum struct {
+	headerByNumberFn                    func(context.Context, *big.Int) (*ethtypes.Header, error)
+	transactionByHashFn                 func(context.Context, eth.Hash) (*ethtypes.Transaction, bool, error)
+	getSendToCosmosEventsFn             func(uint64, uint64) ([]*wrappers.PeggySendToCosmosEvent, error)
+	getSendToInjectiveEventsFn          func(uint64, uint64) ([]*wrappers.PeggySendToInjectiveEvent, error)
+	getTransactionBatchExecutedEventsFn func(uint64, uint64) ([]*wrappers.PeggyTransactionBatchExecutedEvent, error)
+}
+
+func (e mockEthereum) HeaderByNumber(ctx context.Context, number *big.Int) (*ethtypes.Header, error) {
+	return e.headerByNumberFn(ctx, number)
+}
+
+func (e mockEthereum) TransactionByHash(ctx context.Context, txHash eth.Hash) (*ethtypes.Transaction, bool, error) {
+	return e.transactionByHashFn(ctx, txHash)
+}
+
+func (e mockEthereum) TokenDecimals(context.Context, eth.Address) (uint8, error) {
+	return 6, nil
+}
+
+func (e mockEthereum) TokenSymbol(context.Context, eth.Address) (string, error) {
+	return "USDT", nil
+}
+
+func (e mockEthereum) GetSendToCosmosEvents(startBlock, endBlock uint64) ([]*wrappers.PeggySendToCosmosEvent, error) {
+	return e.getSendToCosmosEventsFn(startBlock, endBlock)
+}
+
+func (e mockEthereum) GetSendToInjectiveEvents(startBlock, endBlock uint64) ([]*wrappers.PeggySendToInjectiveEvent, error) {
+	return e.getSendToInjectiveEventsFn(startBlock, endBlock)
+}
+
+func (e mockEthereum) GetTransactionBatchExecutedEvents(startBlock, endBlock uint64) ([]*wrappers.PeggyTransactionBatchExecutedEvent, error) {
+	return e.getTransactionBatchExecutedEventsFn(startBlock, endBlock)
+}
+
+type mockInjective struct {
+	lastObservedEventNonceFn   func(context.Context) (uint64, error)
+	latestTransactionBatchesFn func(context.Context) ([]*peggytypes.OutgoingTxBatch, error)
+}
+
+func (i mockInjective) LastObservedEventNonce(ctx context.Context) (uint64, error) {
+	return i.lastObservedEventNonceFn(ctx)
+}
+
+func (i mockInjective) LatestTransactionBatches(ctx context.Context) ([]*peggytypes.OutgoingTxBatch, error) {
+	return i.latestTransactionBatchesFn(ctx)
+}
+
+// blockTime is a block every 12s starting at genesis.
+func blockTime(number uint64) time.Time {
+	return time.Unix(1600000000+int64(number)*12, 0).UTC()
+}
+
+func headerByNumber(_ context.Context, number *big.Int) (*ethtypes.Header, error) {
+	return &ethtypes.Header{Number: number, Time: uint64(blockTime(number.Uint64()).Unix())}, nil
+}
+
+func TestExporter_Records(t *testing.T) {
+	t.Parallel()
+
+	token := eth.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	sender := eth.HexToAddress("0x9924e52Fe6B833657335C4a71c8347fb2750742b")
+	receiver := eth.HexToAddress("0x8D983cb9388EaC77af0474fA441C4815500Cb7BB")
+	injAddr := cosmtypes.AccAddress(receiver.Bytes())
+
+	var destination [32]byte
+	copy(destination[12:], injAddr.Bytes())
+
+	ethereum := mockEthereum{
+		headerByNumberFn: headerByNumber,
+		getSendToCosmosEventsFn: func(uint64, uint64) ([]*wrappers.PeggySendToCosmosEvent, error) {
+			return nil, nil
+		},
+		getSendToInjectiveEventsFn: func(uint64, uint64) ([]*wrappers.PeggySendToInjectiveEvent, error) {
+			return []*wrappers.PeggySendToInjectiveEvent{
+				{
+					TokenContract: token,
+					Sender:        sender,
+					Destination:   destination,
+					Amount:        big.NewInt(1500000),
+					EventNonce:    big.NewInt(11),
+					Raw:           ethtypes.Log{BlockNumber: 120, Index: 3, TxHash: eth.HexToHash("0x02")},
+				},
+				{
+					TokenContract: token,
+					Sender:        sender,
+					Destination:   destination,
+					Amount:        big.NewInt(2000000),
+					EventNonce:    big.NewInt(10),
+					Raw:           ethtypes.Log{BlockNumber: 110, Index: 0, TxHash: eth.HexToHash("0x01")},
+				},
+			}, nil
+		},
+		getTransactionBatchExecutedEventsFn: func(uint64, uint64) ([]*wrappers.PeggyTransactionBatchExecutedEvent, error) {
+			return []*wrappers.PeggyTransactionBatchExecutedEvent{
+				{
+					BatchNonce: big.NewInt(5),
+					Token:      token,
+					EventNonce: big.NewInt(12),
+					Raw:        ethtypes.Log{BlockNumber: 120, Index: 1, TxHash: eth.HexToHash("0x03")},
+				},
+			}, nil
+		},
+	}
+
+	injective := mockInjective{
+		lastObservedEventNonceFn: func(context.Context) (uint64, error) {
+			return 10, nil
+		},
+		latestTransactionBatchesFn: func(context.Context) ([]*peggytypes.OutgoingTxBatch, error) {
+			return []*peggytypes.OutgoingTxBatch{
+				{
+					BatchNonce:    5,
+					TokenContract: token.Hex(),
+					Transactions: []*peggytypes.OutgoingTransferTx{
+						{
+							Id:          33,
+							Sender:      injAddr.String(),
+							DestAddress: receiver.Hex(),
+							Erc20Token:  &peggytypes.ERC20Token{Contract: token.Hex(), Amount: cosmtypes.NewInt(250000)},
+							Erc20Fee:    &peggytypes.ERC20Token{Contract: token.Hex(), Amount: cosmtypes.NewInt(1000)},
+						},
+					},
+				},
+			}, nil
+		},
+	}
+
+	records, err := NewExporter(ethereum, injective).Records(context.Background(), 100, 200)
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+
+	assert.Equal(t, KindDeposit, records[0].Kind)
+	assert.Equal(t, StatusObserved, records[0].Status)
+	assert.Equal(t, "2", records[0].Amount)
+	assert.Equal(t, sender.Hex(), records[0].Sender)
+	assert.Equal(t, injAddr.String(), records[0].Receiver)
+	assert.Equal(t, blockTime(110), records[0].Timestamp)
+
+	assert.Equal(t, KindWithdrawal, records[1].Kind)
+	assert.Equal(t, StatusExecuted, records[1].Status)
+	assert.Equal(t, uint64(5), records[1].BatchNonce)
+	assert.Equal(t, uint64(33), records[1].TransferID)
+	assert.Equal(t, "0.25", records[1].Amount)
+	assert.Equal(t, "0.001", records[1].Fee)
+	assert.Equal(t, receiver.Hex(), records[1].Receiver)
+	assert.Equal(t, "USDT", records[1].Symbol)
+
+	assert.Equal(t, KindDeposit, records[2].Kind)
+	assert.Equal(t, StatusPending, records[2].Status)
+	assert.Equal(t, "1.5", records[2].Amount)
+}
+
+func TestExporter_Export(t *testing.T) {
+	t.Parallel()
+
+	token := eth.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+
+	ethereum := mockEthereum{
+		headerByNumberFn: headerByNumber,
+		getSendToCosmosEventsFn: func(uint64, uint64) ([]*wrappers.PeggySendToCosmosEvent, error) {
+			return nil, nil
+		},
+		getSendToInjectiveEventsFn: func(from, to uint64) ([]*wrappers.PeggySendToInjectiveEvent, error) {
+			var events []*wrappers.PeggySendToInjectiveEvent
+			for nonce, block := range map[int64]uint64{10: 110, 11: 120} {
+				if block < from || block > to {
+					continue
+				}
+
+				events = append(events, &wrappers.PeggySendToInjectiveEvent{
+					TokenContract: token,
+					Amount:        big.NewInt(1000000),
+					EventNonce:    big.NewInt(nonce),
+					Raw:           ethtypes.Log{BlockNumber: block},
+				})
+			}
+
+			return events, nil
+		},
+		getTransactionBatchExecutedEventsFn: func(uint64, uint64) ([]*wrappers.PeggyTransactionBatchExecutedEvent, error) {
+			return nil, nil
+		},
+	}
+
+	observedNonce := uint64(10)
+	injective := mockInjective{
+		lastObservedEventNonceFn: func(context.Context) (uint64, error) {
+			return observedNonce, nil
+		},
+	}
+
+	var (
+		buf       bytes.Buffer
+		lastBlock uint64
+	)
+
+	done := func(block uint64) error {
+		lastBlock = block
+		return nil
+	}
+
+	w, err := NewWriter(FormatCSV, &buf, false)
+	require.NoError(t, err)
+
+	// the deposit of block 120 isn't observed yet, the export stops right before it
+	require.NoError(t, NewExporter(ethereum, injective).Export(context.Background(), 100, 200, w, done))
+	assert.Equal(t, uint64(119), lastBlock)
+	assert.Len(t, strings.Split(strings.TrimSpace(buf.String()), "\n"), 1)
+
+	// nothing is written while the pending deposit is the first block of the range
+	require.NoError(t, NewExporter(ethereum, injective).Export(context.Background(), 120, 200, w, done))
+	assert.Equal(t, uint64(119), lastBlock)
+
+	observedNonce = 11
+
+	require.NoError(t, NewExporter(ethereum, injective).Export(context.Background(), 120, 200, w, done))
+	assert.Equal(t, uint64(200), lastBlock)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+	assert.True(t, strings.HasPrefix(lines[1], "deposit,observed,11,"))
+}
+
+func TestBlockAtTime(t *testing.T) {
+	t.Parallel()
+
+	ethereum := mockEthereum{headerByNumberFn: headerByNumber}
+
+	block, err := BlockAtTime(context.Background(), ethereum, blockTime(500), 0, 1000)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(500), block)
+
+	// between two blocks the later one is returned
+	block, err = BlockAtTime(context.Background(), ethereum, blockTime(500).Add(time.Second), 0, 1000)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(501), block)
+
+	block, err = BlockAtTime(context.Background(), ethereum, blockTime(2000), 0, 1000)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1001), block)
+}
+
+func TestCSVWriter(t *testing.T) {
+	t.Parallel()
+
+	rec := Record{
+		Kind:       KindDeposit,
+		Status:     StatusObserved,
+		EventNonce: 10,
+		Block:      110,
+		Timestamp:  blockTime(110),
+		Amount:     "2",
+	}
+
+	var buf bytes.Buffer
+	w, err := NewWriter(FormatCSV, &buf, true)
+	require.NoError(t, err)
+	require.NoError(t, w.Write(rec))
+	require.NoError(t, w.Flush())
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+	assert.Equal(t, strings.Join(csvHeader, ","), lines[0])
+	assert.Equal(t, "deposit,observed,10,,,110,2020-09-13T12:48:40Z,,,,2,,,", lines[1])
+}
+
+func TestState(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "export.state")
+
+	state, err := LoadState(path)
+	assert.NoError(t, err)
+	assert.Nil(t, state)
+
+	require.NoError(t, SaveState(path, &State{LastBlock: 12345}))
+
+	state, err = LoadState(path)
+	assert.NoError(t, err)
+	assert.Equal(t, &State{LastBlock: 12345}, state)
+}
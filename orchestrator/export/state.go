@@ -0,0 +1,50 @@
+package export
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+// State is the progress of an incremental export.
+type State struct {
+	LastBlock uint64 `json:"last_block"`
+}
+
+// LoadState reads the state file, it returns nil if the file doesn't exist yet.
+func LoadState(path string) (*State, error) {
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Wrap(err, "failed to read export state")
+	}
+
+	var state State
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse export state %s", path)
+	}
+
+	return &state, nil
+}
+
+// SaveState replaces the state file, so it's never left half-written.
+func SaveState(path string, state *State) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	tmpPath := path + ".tmp"
+	if err := ioutil.WriteFile(tmpPath, data, 0600); err != nil {
+		return errors.Wrap(err, "failed to write export state")
+	}
+
+	if err := os.Rename(tmpPath, path); err != nil {
+		return errors.Wrap(err, "failed to replace export state")
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	FormatCSV   = "csv"
+	FormatJSONL = "jsonl"
+)
+
+// Writer writes records to the export file.
+type Writer interface {
+	Write(rec Record) error
+	Flush() error
+}
+
+// NewWriter returns the writer of the format. The CSV header is written only when
+// writeHeader is set, so resumed exports can append to the existing file.
+func NewWriter(format string, w io.Writer, writeHeader bool) (Writer, error) {
+	switch format {
+	case FormatCSV:
+		return newCSVWriter(w, writeHeader)
+	case FormatJSONL:
+		return &jsonlWriter{enc: json.NewEncoder(w)}, nil
+	default:
+		return nil, errors.Errorf("unknown export format: %s", format)
+	}
+}
+
+var csvHeader = []string{
+	"kind",
+	"status",
+	"event_nonce",
+	"batch_nonce",
+	"transfer_id",
+	"block",
+	"timestamp",
+	"tx_hash",
+	"token",
+	"symbol",
+	"amount",
+	"fee",
+	"sender",
+	"receiver",
+}
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func newCSVWriter(w io.Writer, writeHeader bool) (*csvWriter, error) {
+	cw := &csvWriter{w: csv.NewWriter(w)}
+	if writeHeader {
+		if err := cw.w.Write(csvHeader); err != nil {
+			return nil, err
+		}
+	}
+
+	return cw, nil
+}
+
+func (c *csvWriter) Write(rec Record) error {
+	return c.w.Write([]string{
+		rec.Kind,
+		rec.Status,
+		strconv.FormatUint(rec.EventNonce, 10),
+		formatOptionalUint(rec.BatchNonce),
+		formatOptionalUint(rec.TransferID),
+		strconv.FormatUint(rec.Block, 10),
+		rec.Timestamp.Format(time.RFC3339),
+		rec.TxHash,
+		rec.Token,
+		rec.Symbol,
+		rec.Amount,
+		rec.Fee,
+		rec.Sender,
+		rec.Receiver,
+	})
+}
+
+func (c *csvWriter) Flush() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+func formatOptionalUint(v uint64) string {
+	if v == 0 {
+		return ""
+	}
+
+	return strconv.FormatUint(v, 10)
+}
+
+type jsonlWriter struct {
+	enc *json.Encoder
+}
+
+func (j *jsonlWriter) Write(rec Record) error {
+	return j.enc.Encode(rec)
+}
+
+func (j *jsonlWriter) Flush() error {
+	return nil
+}
@@ -0,0 +1,190 @@
+// Package health serves the liveness and readiness of the orchestrator over HTTP.
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/InjectiveLabs/peggo/orchestrator/loops"
+)
+
+const endpointCheckTimeout = 5 * time.Second
+
+// Endpoint is a remote service the orchestrator depends on.
+type Endpoint struct {
+	Name  string
+	Check func(ctx context.Context) error
+}
+
+type LoopReport struct {
+	Name        string     `json:"name"`
+	LastSuccess *time.Time `json:"last_success,omitempty"`
+	LastError   string     `json:"last_error,omitempty"`
+	LastErrorAt *time.Time `json:"last_error_at,omitempty"`
+	StaleAfter  string     `json:"stale_after"`
+	Stale       bool       `json:"stale"`
+	Stopped     bool       `json:"stopped"`
+	Failed      bool       `json:"failed"`
+}
+
+type EndpointReport struct {
+	Name  string `json:"name"`
+	OK    bool   `json:"ok"`
+	Error string `json:"error,omitempty"`
+}
+
+type Report struct {
+	OK        bool             `json:"ok"`
+	Loops     []LoopReport     `json:"loops"`
+	Endpoints []EndpointReport `json:"endpoints,omitempty"`
+}
+
+// Checker reports the state of the loops and the connectivity of the endpoints.
+type Checker struct {
+	mux       sync.RWMutex
+	endpoints []Endpoint
+	now       func() time.Time
+}
+
+func NewChecker(endpoints ...Endpoint) *Checker {
+	return &Checker{
+		endpoints: endpoints,
+		now:       time.Now,
+	}
+}
+
+// SetEndpoints replaces the checked endpoints, the checker is usually served
+// before the connections are made.
+func (c *Checker) SetEndpoints(endpoints ...Endpoint) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	c.endpoints = endpoints
+}
+
+// Liveness is OK while every loop keeps completing iterations. Endpoints aren't checked,
+// since restarting peggo doesn't fix a node that's down and a slow node mustn't block the probe.
+func (c *Checker) Liveness(_ context.Context) Report {
+	report := Report{
+		OK:    true,
+		Loops: c.loopReports(),
+	}
+
+	for _, l := range report.Loops {
+		// loops also stop gracefully, only a failed one is fatal
+		if l.Stale || l.Failed {
+			report.OK = false
+		}
+	}
+
+	return report
+}
+
+// Readiness is OK when the orchestrator is live, every endpoint is reachable and
+// every loop completed its first iteration.
+func (c *Checker) Readiness(ctx context.Context) Report {
+	report := c.Liveness(ctx)
+	report.Endpoints = c.checkEndpoints(ctx)
+
+	for _, e := range report.Endpoints {
+		if !e.OK {
+			report.OK = false
+		}
+	}
+
+	for _, l := range report.Loops {
+		if l.LastSuccess == nil {
+			report.OK = false
+		}
+	}
+
+	// no loops means the orchestrator hasn't started yet
+	if len(report.Loops) == 0 {
+		report.OK = false
+	}
+
+	return report
+}
+
+func (c *Checker) loopReports() []LoopReport {
+	now := c.now()
+	statuses := loops.Statuses()
+
+	reports := make([]LoopReport, len(statuses))
+	for i, s := range statuses {
+		reports[i] = LoopReport{
+			Name:       s.Name,
+			LastError:  s.LastError,
+			StaleAfter: s.StaleAfter.String(),
+			Stale:      !s.Stopped && s.Stale(now),
+			Stopped:    s.Stopped,
+			Failed:     s.Failed,
+		}
+
+		if !s.LastSuccess.IsZero() {
+			lastSuccess := s.LastSuccess
+			reports[i].LastSuccess = &lastSuccess
+		}
+
+		if !s.LastErrorAt.IsZero() {
+			lastErrorAt := s.LastErrorAt
+			reports[i].LastErrorAt = &lastErrorAt
+		}
+	}
+
+	return reports
+}
+
+func (c *Checker) checkEndpoints(ctx context.Context) []EndpointReport {
+	c.mux.RLock()
+	endpoints := c.endpoints
+	c.mux.RUnlock()
+
+	ctx, cancelFn := context.WithTimeout(ctx, endpointCheckTimeout)
+	defer cancelFn()
+
+	reports := make([]EndpointReport, len(endpoints))
+
+	var wg sync.WaitGroup
+	for i, e := range endpoints {
+		wg.Add(1)
+
+		go func(i int, e Endpoint) {
+			defer wg.Done()
+
+			reports[i] = EndpointReport{Name: e.Name, OK: true}
+			if err := e.Check(ctx); err != nil {
+				reports[i].OK = false
+				reports[i].Error = err.Error()
+			}
+		}(i, e)
+	}
+
+	wg.Wait()
+
+	return reports
+}
+
+// Register adds the /healthz and /readyz handlers to the mux.
+func (c *Checker) Register(mux *http.ServeMux) {
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		writeReport(w, c.Liveness(r.Context()))
+	})
+
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+		writeReport(w, c.Readiness(r.Context()))
+	})
+}
+
+func writeReport(w http.ResponseWriter, report Report) {
+	w.Header().Set("Content-Type", "application/json")
+
+	if !report.OK {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+
+	_ = json.NewEncoder(w).Encode(report)
+}
@@ -0,0 +1,87 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/InjectiveLabs/peggo/orchestrator/loops"
+)
+
+func TestChecker(t *testing.T) {
+	ctx, cancelFn := context.WithCancel(context.Background())
+	defer cancelFn()
+
+	go func() {
+		_ = loops.RunLoop(ctx, "TestLoop", time.Hour, func() error { return nil })
+	}()
+
+	require.Eventually(t, func() bool {
+		for _, s := range loops.Statuses() {
+			if s.Name == "TestLoop" && !s.LastSuccess.IsZero() {
+				return true
+			}
+		}
+
+		return false
+	}, time.Second, 10*time.Millisecond)
+
+	nodeDown := false
+	checker := NewChecker(Endpoint{
+		Name: "node",
+		Check: func(context.Context) error {
+			if nodeDown {
+				return errors.New("connection refused")
+			}
+
+			return nil
+		},
+	})
+
+	mux := http.NewServeMux()
+	checker.Register(mux)
+
+	statusCode := func(path string) int {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest("GET", path, nil))
+		return rec.Code
+	}
+
+	assert.Equal(t, http.StatusOK, statusCode("/healthz"))
+	assert.Equal(t, http.StatusOK, statusCode("/readyz"))
+
+	// a node outage makes peggo unready, but restarting it wouldn't help
+	nodeDown = true
+	assert.Equal(t, http.StatusOK, statusCode("/healthz"))
+	assert.Equal(t, http.StatusServiceUnavailable, statusCode("/readyz"))
+
+	report := checker.Readiness(context.Background())
+	require.Len(t, report.Endpoints, 1)
+	assert.Equal(t, "connection refused", report.Endpoints[0].Error)
+
+	// liveness doesn't wait on the endpoints
+	assert.Empty(t, checker.Liveness(context.Background()).Endpoints)
+
+	// a retried error is reported without failing the loop
+	loops.ReportError("TestLoop", errors.New("request timed out"))
+	report = checker.Liveness(context.Background())
+	assert.True(t, report.OK)
+	for _, l := range report.Loops {
+		if l.Name == "TestLoop" {
+			assert.Equal(t, "request timed out", l.LastError)
+			assert.False(t, l.Failed)
+		}
+	}
+
+	// no iteration for three intervals
+	nodeDown = false
+	checker.now = func() time.Time { return time.Now().Add(4 * time.Hour) }
+	assert.Equal(t, http.StatusServiceUnavailable, statusCode("/healthz"))
+	assert.Equal(t, http.StatusServiceUnavailable, statusCode("/readyz"))
+}
@@ -16,7 +16,10 @@ var ErrGracefulStop = errors.New("stop")
 // Loop runs a function in the loop with a consistent interval. If execution takes longer,
 // the waiting time between iteration decreases. A single iteration has a deadline and cannot run longer
 // than interval itself. There is a protection from panic which could crash adjacent loops.
-func RunLoop(ctx context.Context, interval time.Duration, fn func() error) (err error) {
+// The iterations of the loop are tracked under its name, see Statuses.
+func RunLoop(ctx context.Context, name string, interval time.Duration, fn func() error) (err error) {
+	trackStart(name, interval)
+	defer func() { trackStop(name, err) }()
 	defer panicRecover(&err)
 
 	delayTimer := time.NewTimer(0)
@@ -32,6 +35,8 @@ func RunLoop(ctx context.Context, interval time.Duration, fn func() error) (err
 				return fnErr
 			}
 
+			trackSuccess(name)
+
 			if elapsed := time.Since(start); elapsed >= interval {
 				// in case of an overlap, use just interval
 				delayTimer.Reset(interval)
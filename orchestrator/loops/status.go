@@ -0,0 +1,108 @@
+package loops
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// staleIntervals is how many intervals a loop may go without a successful
+// iteration before it's reported stale, e.g. while it's stuck retrying.
+const staleIntervals = 3
+
+// Status is the liveness of a loop run by RunLoop.
+type Status struct {
+	Name        string
+	Interval    time.Duration
+	StaleAfter  time.Duration
+	StartedAt   time.Time
+	LastSuccess time.Time
+	LastError   string
+	LastErrorAt time.Time
+	// Stopped is set when the loop exited, with Failed and LastError if it failed.
+	// LastError is also set by ReportError while the loop keeps running.
+	Stopped bool
+	Failed  bool
+}
+
+// Stale tells if the loop had no successful iteration within StaleAfter.
+func (s Status) Stale(now time.Time) bool {
+	last := s.LastSuccess
+	if last.IsZero() {
+		last = s.StartedAt
+	}
+
+	return now.Sub(last) > s.StaleAfter
+}
+
+var (
+	statusMux sync.RWMutex
+	statuses  = make(map[string]*Status)
+)
+
+// Statuses returns the status of every loop started in the process, sorted by name.
+func Statuses() []Status {
+	statusMux.RLock()
+	defer statusMux.RUnlock()
+
+	list := make([]Status, 0, len(statuses))
+	for _, s := range statuses {
+		list = append(list, *s)
+	}
+
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+
+	return list
+}
+
+// ReportError records a failed attempt within an iteration of the named loop, e.g. from
+// a retry.OnRetry callback, so the error is reported while the loop is still retrying.
+func ReportError(name string, err error) {
+	if err == nil {
+		return
+	}
+
+	updateStatus(name, func(s *Status) {
+		s.LastError = err.Error()
+		s.LastErrorAt = time.Now()
+	})
+}
+
+func trackStart(name string, interval time.Duration) {
+	statusMux.Lock()
+	defer statusMux.Unlock()
+
+	statuses[name] = &Status{
+		Name:       name,
+		Interval:   interval,
+		StaleAfter: staleIntervals * interval,
+		StartedAt:  time.Now(),
+	}
+}
+
+func trackSuccess(name string) {
+	updateStatus(name, func(s *Status) {
+		s.LastSuccess = time.Now()
+	})
+}
+
+func trackStop(name string, err error) {
+	updateStatus(name, func(s *Status) {
+		s.Stopped = true
+
+		if err != nil {
+			s.Failed = true
+			s.LastError = err.Error()
+			s.LastErrorAt = time.Now()
+		}
+	})
+}
+
+func updateStatus(name string, fn func(s *Status)) {
+	statusMux.Lock()
+	defer statusMux.Unlock()
+
+	if s, ok := statuses[name]; ok {
+		fn(s)
+	}
+}
@@ -0,0 +1,60 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// BridgeGauge is a bridge health gauge. Every update also records the Injective
+// height it was observed at, so a stale gauge can be told apart from a healthy one.
+type BridgeGauge struct {
+	value  prometheus.Gauge
+	height prometheus.Gauge
+}
+
+var bridgeHeights = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: namespace,
+	Subsystem: "bridge",
+	Name:      "injective_height",
+	Help:      "Injective height at which a bridge gauge was last updated.",
+}, []string{"gauge"})
+
+var (
+	// EventNonceLag is the number of Ethereum events in the Peggy contract not yet claimed by the orchestrator.
+	EventNonceLag = newBridgeGauge("event_nonce_lag", "Peggy contract event nonce minus the nonce of the last event claimed by the orchestrator.")
+	// EthBlockLag is how far the oracle is behind the Ethereum head, including the confirmation delay.
+	EthBlockLag = newBridgeGauge("eth_block_lag", "Latest Ethereum block minus the last block scanned by the oracle.")
+
+	UnsignedValsets         = newBridgeGauge("unsigned_valsets", "Valsets waiting for the orchestrator's confirmation.")
+	UnsignedValsetAgeBlocks = newBridgeGauge("unsigned_valset_age_blocks", "Injective blocks since the oldest unsigned valset was created.")
+	UnsignedBatches         = newBridgeGauge("unsigned_batches", "Batches waiting for the orchestrator's confirmation.")
+	UnsignedBatchAgeBlocks  = newBridgeGauge("unsigned_batch_age_blocks", "Injective blocks since the oldest unsigned batch was created.")
+
+	// UnexecutedBatches are batches with enough confirmations that no relayer has submitted yet.
+	UnexecutedBatches = newBridgeGauge("unexecuted_batches", "Batches confirmed by the valset on Ethereum but not executed there yet.")
+)
+
+func init() {
+	registry.MustRegister(bridgeHeights)
+}
+
+func newBridgeGauge(name, help string) *BridgeGauge {
+	value := prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "bridge",
+		Name:      name,
+		Help:      help,
+	})
+
+	registry.MustRegister(value)
+
+	return &BridgeGauge{
+		value:  value,
+		height: bridgeHeights.WithLabelValues(name),
+	}
+}
+
+// Set updates the gauge with a value observed at the Injective height.
+func (g *BridgeGauge) Set(injectiveHeight int64, v float64) {
+	g.value.Set(v)
+	g.height.Set(float64(injectiveHeight))
+}
@@ -0,0 +1,21 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var senderBalance = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: namespace,
+	Subsystem: "committer",
+	Name:      "sender_balance_eth",
+	Help:      "Last known ETH balance of a committer sender account.",
+}, []string{"account"})
+
+func init() {
+	registry.MustRegister(senderBalance)
+}
+
+// SetSenderBalance records the ETH balance of a committer sender account.
+func SetSenderBalance(account string, balance float64) {
+	senderBalance.WithLabelValues(account).Set(balance)
+}
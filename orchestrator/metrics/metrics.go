@@ -0,0 +1,136 @@
+// Package metrics reports function calls, errors and timings to statsd through
+// InjectiveLabs/metrics and, once enabled, to Prometheus.
+//
+// It mirrors the reporting functions of InjectiveLabs/metrics, so instrumented
+// code only has to import this package instead.
+package metrics
+
+import (
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	statsd "github.com/InjectiveLabs/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "peggo"
+
+type Tags = statsd.Tags
+
+var (
+	registry = prometheus.NewRegistry()
+
+	// prometheusEnabled skips the Prometheus collectors when no one scrapes them.
+	prometheusEnabled int32
+
+	funcCalls = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "func_calls_total",
+		Help:      "Number of calls of an instrumented function.",
+	}, []string{"svc", "func"})
+
+	funcErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "func_errors_total",
+		Help:      "Number of errors returned by an instrumented function.",
+	}, []string{"svc", "func"})
+
+	funcDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "func_duration_seconds",
+		Help:      "Duration of an instrumented function.",
+		// from a cached query to a tx waiting for its block
+		Buckets: []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60},
+	}, []string{"svc", "func"})
+)
+
+func init() {
+	registry.MustRegister(
+		funcCalls,
+		funcErrors,
+		funcDuration,
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+}
+
+// EnablePrometheus starts recording the function metrics in the Prometheus registry.
+func EnablePrometheus() {
+	atomic.StoreInt32(&prometheusEnabled, 1)
+}
+
+func isPrometheusEnabled() bool {
+	return atomic.LoadInt32(&prometheusEnabled) == 1
+}
+
+// Register adds collectors to the registry served by Handler.
+func Register(cs ...prometheus.Collector) error {
+	for _, c := range cs {
+		if err := registry.Register(c); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Handler serves the Prometheus metrics.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+}
+
+// ReportFuncCall reports a call of the calling function.
+func ReportFuncCall(tags ...Tags) {
+	fn := statsd.CallerFuncName()
+	statsd.ReportClosureFuncCall(fn, tags...)
+
+	if isPrometheusEnabled() {
+		funcCalls.WithLabelValues(svcLabel(tags), fn).Inc()
+	}
+}
+
+// ReportFuncError reports an error of the calling function.
+func ReportFuncError(tags ...Tags) {
+	fn := statsd.CallerFuncName()
+	statsd.ReportClosureFuncError(fn, tags...)
+
+	if isPrometheusEnabled() {
+		funcErrors.WithLabelValues(svcLabel(tags), fn).Inc()
+	}
+}
+
+// ReportFuncTiming starts timing the calling function, the returned func stops the timer.
+func ReportFuncTiming(tags ...Tags) statsd.StopTimerFunc {
+	fn := statsd.CallerFuncName()
+	stopFn := statsd.ReportClosureFuncTiming(fn, tags...)
+
+	if !isPrometheusEnabled() {
+		return stopFn
+	}
+
+	start := time.Now()
+	observer := funcDuration.WithLabelValues(svcLabel(tags), fn)
+
+	return func() {
+		stopFn()
+		observer.Observe(time.Since(start).Seconds())
+	}
+}
+
+// svcLabel is the service of the tags, the committer accounts tag it as module.
+func svcLabel(tags []Tags) string {
+	for _, t := range tags {
+		if svc, ok := t["svc"]; ok {
+			return svc
+		}
+
+		if module, ok := t["module"]; ok {
+			return module
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,53 @@
+package metrics
+
+import (
+	"errors"
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	statsd "github.com/InjectiveLabs/metrics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func instrumentedCall(fail bool) error {
+	tags := Tags{"svc": "metrics_test"}
+
+	ReportFuncCall(tags)
+	doneFn := ReportFuncTiming(tags)
+	defer doneFn()
+
+	if fail {
+		ReportFuncError(tags)
+		return errors.New("failed")
+	}
+
+	return nil
+}
+
+func TestPrometheusHandler(t *testing.T) {
+	statsd.Disable()
+	EnablePrometheus()
+
+	_ = instrumentedCall(false)
+	_ = instrumentedCall(true)
+
+	rec := httptest.NewRecorder()
+	Handler().ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+
+	body, err := io.ReadAll(rec.Body)
+	require.NoError(t, err)
+
+	assert.Regexp(t, `peggo_func_calls_total\{func="[^"]*instrumentedCall",svc="metrics_test"\} 2`, string(body))
+	assert.Regexp(t, `peggo_func_errors_total\{func="[^"]*instrumentedCall",svc="metrics_test"\} 1`, string(body))
+	assert.Regexp(t, `peggo_func_duration_seconds_count\{func="[^"]*instrumentedCall",svc="metrics_test"\} 2`, string(body))
+}
+
+func TestSvcLabel(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "peggy_query", svcLabel([]Tags{{"svc": "peggy_query"}}))
+	assert.Equal(t, "eth_committer", svcLabel([]Tags{{"module": "eth_committer", "account": "0x00"}}))
+	assert.Equal(t, "", svcLabel(nil))
+}
@@ -0,0 +1,38 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	priceSourceUp = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "price_feed",
+		Name:      "source_up",
+		Help:      "Whether the last query of a price source succeeded.",
+	}, []string{"source"})
+
+	priceSourceLastSuccess = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "price_feed",
+		Name:      "source_last_success_timestamp_seconds",
+		Help:      "Unix time of the last successful query of a price source.",
+	}, []string{"source"})
+)
+
+func init() {
+	registry.MustRegister(priceSourceUp, priceSourceLastSuccess)
+}
+
+// ReportPriceSource records the outcome of a price source query.
+func ReportPriceSource(source string, ok bool) {
+	if !ok {
+		priceSourceUp.WithLabelValues(source).Set(0)
+		return
+	}
+
+	priceSourceUp.WithLabelValues(source).Set(1)
+	priceSourceLastSuccess.WithLabelValues(source).Set(float64(time.Now().Unix()))
+}
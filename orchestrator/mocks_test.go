@@ -7,6 +7,7 @@ import (
 	eth "github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"math/big"
+	"time"
 
 	peggytypes "github.com/InjectiveLabs/sdk-go/chain/peggy/types"
 )
@@ -23,6 +24,13 @@ type mockInjective struct {
 	unbatchedTokenFeesFn        func(context.Context) ([]*peggytypes.BatchFees, error)
 	unbatchedTokenFeesCallCount int
 
+	unbatchedTransfersFn        func(context.Context) ([]*peggytypes.OutgoingTransferTx, error)
+	unbatchedTransfersCallCount int
+	transferCreatedAtFn         func(context.Context, uint64) (time.Time, error)
+	erc20ToDenomsFn             func(context.Context) ([]*peggytypes.ERC20ToDenom, error)
+	erc20ToDenomFn              func(context.Context, eth.Address) (string, bool, error)
+	erc20ToDenomCallCount       int
+
 	sendRequestBatchFn        func(context.Context, string) error
 	sendRequestBatchCallCount int
 
@@ -45,8 +53,9 @@ type mockInjective struct {
 	oldestUnsignedTransactionBatchFn func(context.Context) (*peggytypes.OutgoingTxBatch, error)
 	sendBatchConfirmFn               func(context.Context, eth.Hash, *peggytypes.OutgoingTxBatch, eth.Address) error
 
-	latestValsetsFn func(context.Context) ([]*peggytypes.Valset, error)
-	getBlockFn      func(context.Context, int64) (*tmctypes.ResultBlock, error)
+	latestValsetsFn        func(context.Context) ([]*peggytypes.Valset, error)
+	getBlockFn             func(context.Context, int64) (*tmctypes.ResultBlock, error)
+	getLatestBlockHeightFn func(context.Context) (int64, error)
 
 	allValsetConfirmsFn func(context.Context, uint64) ([]*peggytypes.MsgValsetConfirm, error)
 	valsetAtFn          func(context.Context, uint64) (*peggytypes.Valset, error)
@@ -60,6 +69,24 @@ func (i *mockInjective) UnbatchedTokenFees(ctx context.Context) ([]*peggytypes.B
 	return i.unbatchedTokenFeesFn(ctx)
 }
 
+func (i *mockInjective) UnbatchedTransfers(ctx context.Context) ([]*peggytypes.OutgoingTransferTx, error) {
+	i.unbatchedTransfersCallCount++
+	return i.unbatchedTransfersFn(ctx)
+}
+
+func (i *mockInjective) TransferCreatedAt(ctx context.Context, id uint64) (time.Time, error) {
+	return i.transferCreatedAtFn(ctx, id)
+}
+
+func (i *mockInjective) ERC20ToDenoms(ctx context.Context) ([]*peggytypes.ERC20ToDenom, error) {
+	return i.erc20ToDenomsFn(ctx)
+}
+
+func (i *mockInjective) ERC20ToDenom(ctx context.Context, tokenContract eth.Address) (string, bool, error) {
+	i.erc20ToDenomCallCount++
+	return i.erc20ToDenomFn(ctx, tokenContract)
+}
+
 func (i *mockInjective) SendRequestBatch(ctx context.Context, denom string) error {
 	i.sendRequestBatchCallCount++
 	return i.sendRequestBatchFn(ctx, denom)
@@ -110,6 +137,10 @@ func (i *mockInjective) GetBlock(ctx context.Context, height int64) (*tmctypes.R
 	return i.getBlockFn(ctx, height)
 }
 
+func (i *mockInjective) GetLatestBlockHeight(ctx context.Context) (int64, error) {
+	return i.getLatestBlockHeightFn(ctx)
+}
+
 func (i *mockInjective) LatestValsets(ctx context.Context) ([]*peggytypes.Valset, error) {
 	return i.latestValsetsFn(ctx)
 }
@@ -136,14 +167,21 @@ func (i *mockInjective) TransactionBatchSignatures(ctx context.Context, nonce ui
 
 type mockEthereum struct {
 	fromAddressFn                       func() eth.Address
+	senderAddressesFn                   func() []eth.Address
+	balanceAtFn                         func(context.Context, eth.Address) (*big.Int, error)
 	headerByNumberFn                    func(context.Context, *big.Int) (*ethtypes.Header, error)
+	transactionReceiptFn                func(context.Context, eth.Hash) (*ethtypes.Receipt, error)
 	getSendToCosmosEventsFn             func(uint64, uint64) ([]*peggyevents.PeggySendToCosmosEvent, error)
 	getSendToInjectiveEventsFn          func(uint64, uint64) ([]*peggyevents.PeggySendToInjectiveEvent, error)
 	getPeggyERC20DeployedEventsFn       func(uint64, uint64) ([]*peggyevents.PeggyERC20DeployedEvent, error)
 	getValsetUpdatedEventsFn            func(uint64, uint64) ([]*peggyevents.PeggyValsetUpdatedEvent, error)
 	getTransactionBatchExecutedEventsFn func(uint64, uint64) ([]*peggyevents.PeggyTransactionBatchExecutedEvent, error)
 	getPeggyIDFn                        func(context.Context) (eth.Hash, error)
+	tokenDecimalsFn                     func(context.Context, eth.Address) (uint8, error)
+	batchGasPriceFn                     func(context.Context) (*big.Int, error)
 	getValsetNonceFn                    func(context.Context) (*big.Int, error)
+	getLastEventNonceFn                 func(context.Context) (*big.Int, error)
+	getValsetCheckpointFn               func(context.Context) (eth.Hash, error)
 	sendEthValsetUpdateFn               func(context.Context, *peggytypes.Valset, *peggytypes.Valset, []*peggytypes.MsgValsetConfirm) (*eth.Hash, error)
 	getTxBatchNonceFn                   func(context.Context, eth.Address) (*big.Int, error)
 	sendTransactionBatchFn              func(context.Context, *peggytypes.Valset, *peggytypes.OutgoingTxBatch, []*peggytypes.MsgConfirmBatch) (*eth.Hash, error)
@@ -153,10 +191,30 @@ func (e mockEthereum) FromAddress() eth.Address {
 	return e.fromAddressFn()
 }
 
+func (e mockEthereum) SenderAddresses() []eth.Address {
+	return e.senderAddressesFn()
+}
+
+func (e mockEthereum) BalanceAt(ctx context.Context, account eth.Address) (*big.Int, error) {
+	return e.balanceAtFn(ctx, account)
+}
+
 func (e mockEthereum) HeaderByNumber(ctx context.Context, number *big.Int) (*ethtypes.Header, error) {
 	return e.headerByNumberFn(ctx, number)
 }
 
+func (e mockEthereum) TransactionReceipt(ctx context.Context, txHash eth.Hash) (*ethtypes.Receipt, error) {
+	return e.transactionReceiptFn(ctx, txHash)
+}
+
+func (e mockEthereum) TokenDecimals(ctx context.Context, tokenAddr eth.Address) (uint8, error) {
+	return e.tokenDecimalsFn(ctx, tokenAddr)
+}
+
+func (e mockEthereum) BatchGasPrice(ctx context.Context) (*big.Int, error) {
+	return e.batchGasPriceFn(ctx)
+}
+
 func (e mockEthereum) GetSendToCosmosEvents(startBlock, endBlock uint64) ([]*peggyevents.PeggySendToCosmosEvent, error) {
 	return e.getSendToCosmosEventsFn(startBlock, endBlock)
 }
@@ -185,6 +243,14 @@ func (e mockEthereum) GetValsetNonce(ctx context.Context) (*big.Int, error) {
 	return e.getValsetNonceFn(ctx)
 }
 
+func (e mockEthereum) GetLastEventNonce(ctx context.Context) (*big.Int, error) {
+	return e.getLastEventNonceFn(ctx)
+}
+
+func (e mockEthereum) GetValsetCheckpoint(ctx context.Context) (eth.Hash, error) {
+	return e.getValsetCheckpointFn(ctx)
+}
+
 func (e mockEthereum) SendEthValsetUpdate(
 	ctx context.Context,
 	oldValset *peggytypes.Valset,
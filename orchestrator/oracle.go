@@ -2,12 +2,14 @@ package orchestrator
 
 import (
 	"context"
+	"sync/atomic"
 	"time"
 
 	"github.com/avast/retry-go"
 	"github.com/pkg/errors"
 	log "github.com/xlab/suplog"
 
+	"github.com/InjectiveLabs/peggo/orchestrator/alerts"
 	"github.com/InjectiveLabs/peggo/orchestrator/loops"
 	wrappers "github.com/InjectiveLabs/peggo/solidity/wrappers/Peggy.sol"
 )
@@ -37,8 +39,13 @@ func (s *PeggyOrchestrator) EthOracleMainLoop(ctx context.Context) error {
 
 	return loops.RunLoop(
 		ctx,
+		"EthOracle",
 		defaultLoopDur,
-		func() error { return oracle.run(ctx, s.injective, s.ethereum) },
+		func() error {
+			err := oracle.run(ctx, s.injective, s.ethereum)
+			atomic.StoreUint64(&s.oracleEthHeight, oracle.lastCheckedEthHeight)
+			return err
+		},
 	)
 }
 
@@ -230,6 +237,10 @@ func (o *ethOracle) relayEvents(
 			erc20Deployments,
 			valsetUpdates,
 		); err != nil {
+			alerts.Warning("claims_failed", "failed to send Ethereum event claims to Injective", map[string]interface{}{
+				"last_claim_event_nonce": lastClaimEvent.EthereumEventNonce,
+				"error":                  err.Error(),
+			})
 			return errors.Wrap(err, "failed to send event claims to Injective")
 		}
 
@@ -250,9 +261,14 @@ func (o *ethOracle) relayEvents(
 		retry.Attempts(o.retries),
 		retry.OnRetry(func(n uint, err error) {
 			o.log.WithError(err).Warningf("error during Ethereum event checking, will retry (%d)", n)
+			loops.ReportError("EthOracle", err)
 		}),
 	); err != nil {
 		o.log.WithError(err).Errorln("got error, loop exits")
+		alerts.Critical("oracle_failed", "EthOracle failed to relay Ethereum events to Injective and exits", map[string]interface{}{
+			"last_checked_eth_height": o.lastCheckedEthHeight,
+			"error":                   err.Error(),
+		})
 		return 0, err
 	}
 
@@ -276,6 +292,7 @@ func (o *ethOracle) autoResync(ctx context.Context, injective InjectiveNetwork)
 		retry.Attempts(o.retries),
 		retry.OnRetry(func(n uint, err error) {
 			o.log.WithError(err).Warningf("failed to get last confirmed eth height, will retry (%d)", n)
+			loops.ReportError("EthOracle", err)
 		}),
 	); err != nil {
 		o.log.WithError(err).Errorln("got error, loop exits")
@@ -11,8 +11,8 @@ import (
 	"github.com/pkg/errors"
 	log "github.com/xlab/suplog"
 
-	"github.com/InjectiveLabs/metrics"
 	"github.com/InjectiveLabs/peggo/orchestrator/loops"
+	"github.com/InjectiveLabs/peggo/orchestrator/metrics"
 	peggyevents "github.com/InjectiveLabs/peggo/solidity/wrappers/Peggy.sol"
 	peggytypes "github.com/InjectiveLabs/sdk-go/chain/peggy/types"
 )
@@ -24,6 +24,7 @@ type PriceFeed interface {
 type InjectiveNetwork interface {
 	PeggyParams(ctx context.Context) (*peggytypes.Params, error)
 	GetBlock(ctx context.Context, height int64) (*tmctypes.ResultBlock, error)
+	GetLatestBlockHeight(ctx context.Context) (int64, error)
 
 	// claims
 	LastClaimEvent(ctx context.Context) (*peggytypes.LastClaimEvent, error)
@@ -39,6 +40,10 @@ type InjectiveNetwork interface {
 
 	// batches
 	UnbatchedTokenFees(ctx context.Context) ([]*peggytypes.BatchFees, error)
+	UnbatchedTransfers(ctx context.Context) ([]*peggytypes.OutgoingTransferTx, error)
+	TransferCreatedAt(ctx context.Context, id uint64) (time.Time, error)
+	ERC20ToDenoms(ctx context.Context) ([]*peggytypes.ERC20ToDenom, error)
+	ERC20ToDenom(ctx context.Context, tokenContract eth.Address) (denom string, cosmosOriginated bool, err error)
 	SendRequestBatch(ctx context.Context, denom string) error
 	OldestUnsignedTransactionBatch(ctx context.Context) (*peggytypes.OutgoingTxBatch, error)
 	SendBatchConfirm(ctx context.Context, peggyID eth.Hash, batch *peggytypes.OutgoingTxBatch, ethFrom eth.Address) error
@@ -55,10 +60,16 @@ type InjectiveNetwork interface {
 
 type EthereumNetwork interface {
 	FromAddress() eth.Address
+	SenderAddresses() []eth.Address
+	BalanceAt(ctx context.Context, account eth.Address) (*big.Int, error)
 	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	TransactionReceipt(ctx context.Context, txHash eth.Hash) (*types.Receipt, error)
 	GetPeggyID(ctx context.Context) (eth.Hash, error)
+	TokenDecimals(ctx context.Context, tokenAddr eth.Address) (uint8, error)
+	BatchGasPrice(ctx context.Context) (*big.Int, error)
 
 	// events
+	GetLastEventNonce(ctx context.Context) (*big.Int, error)
 	GetSendToCosmosEvents(startBlock, endBlock uint64) ([]*peggyevents.PeggySendToCosmosEvent, error)
 	GetSendToInjectiveEvents(startBlock, endBlock uint64) ([]*peggyevents.PeggySendToInjectiveEvent, error)
 	GetPeggyERC20DeployedEvents(startBlock, endBlock uint64) ([]*peggyevents.PeggyERC20DeployedEvent, error)
@@ -67,6 +78,7 @@ type EthereumNetwork interface {
 
 	// valsets
 	GetValsetNonce(ctx context.Context) (*big.Int, error)
+	GetValsetCheckpoint(ctx context.Context) (eth.Hash, error)
 	SendEthValsetUpdate(
 		ctx context.Context,
 		oldValset *peggytypes.Valset,
@@ -96,23 +108,37 @@ type PeggyOrchestrator struct {
 	ethereum  EthereumNetwork
 	pricefeed PriceFeed
 
-	erc20ContractMapping map[eth.Address]string
+	// ethSignerAddr is the registered orchestrator key, used only for signing confirms.
+	// Ethereum txs are sent from the committer account of EthereumNetwork.
+	ethSignerAddr eth.Address
+
+	erc20ContractMapping *TokenMapping
 	relayValsetOffsetDur time.Duration
 	relayBatchOffsetDur  time.Duration
 	minBatchFeeUSD       float64
+	batchPolicy          *BatchPolicy
+	dynamicBatchFee      *DynamicBatchFee
 	maxAttempts          uint // max number of times a retry func will be called before exiting
+	balanceWatcher       *balanceWatcher
 
 	valsetRelayEnabled      bool
 	batchRelayEnabled       bool
 	periodicBatchRequesting bool
+
+	// oracleEthHeight is the last Ethereum block scanned by the oracle, accessed atomically.
+	oracleEthHeight uint64
 }
 
 func NewPeggyOrchestrator(
 	injective InjectiveNetwork,
 	ethereum EthereumNetwork,
+	ethSignerAddr eth.Address,
 	priceFeed PriceFeed,
-	erc20ContractMapping map[eth.Address]string,
+	erc20ContractMapping *TokenMapping,
 	minBatchFeeUSD float64,
+	batchPolicy *BatchPolicy,
+	dynamicBatchFee *DynamicBatchFee,
+	balanceWatch *BalanceWatch,
 	valsetRelayingEnabled,
 	batchRelayingEnabled bool,
 	valsetRelayingOffset,
@@ -122,14 +148,21 @@ func NewPeggyOrchestrator(
 		svcTags:              metrics.Tags{"svc": "peggy_orchestrator"},
 		injective:            injective,
 		ethereum:             ethereum,
+		ethSignerAddr:        ethSignerAddr,
 		pricefeed:            priceFeed,
 		erc20ContractMapping: erc20ContractMapping,
 		minBatchFeeUSD:       minBatchFeeUSD,
+		batchPolicy:          batchPolicy,
+		dynamicBatchFee:      dynamicBatchFee,
 		valsetRelayEnabled:   valsetRelayingEnabled,
 		batchRelayEnabled:    batchRelayingEnabled,
 		maxAttempts:          10, // default is 10 for retry pkg
 	}
 
+	if balanceWatch != nil && (valsetRelayingEnabled || batchRelayingEnabled) {
+		orch.balanceWatcher = newBalanceWatcher(balanceWatch)
+	}
+
 	if valsetRelayingEnabled {
 		dur, err := time.ParseDuration(valsetRelayingOffset)
 		if err != nil {
@@ -154,6 +187,11 @@ func NewPeggyOrchestrator(
 // Run starts all major loops required to make
 // up the Orchestrator, all of these are async loops.
 func (s *PeggyOrchestrator) Run(ctx context.Context, validatorMode bool) error {
+	// load the token mapping before the batch requester needs it
+	if err := s.refreshTokenMapping(ctx); err != nil {
+		log.WithError(err).Warningln("unable to load ERC20 token mapping from Injective")
+	}
+
 	if !validatorMode {
 		return s.startRelayerMode(ctx)
 	}
@@ -175,9 +213,16 @@ func (s *PeggyOrchestrator) startValidatorMode(ctx context.Context) error {
 	var pg loops.ParanoidGroup
 
 	pg.Go(func() error { return s.EthOracleMainLoop(ctx) })
+	pg.Go(func() error { return s.TokenMappingLoop(ctx) })
 	pg.Go(func() error { return s.BatchRequesterLoop(ctx) })
 	pg.Go(func() error { return s.EthSignerMainLoop(ctx) })
 	pg.Go(func() error { return s.RelayerMainLoop(ctx) })
+	pg.Go(func() error { return s.CheckpointVerifierLoop(ctx) })
+	pg.Go(func() error { return s.BridgeMetricsLoop(ctx, true) })
+
+	if s.balanceWatcher != nil {
+		pg.Go(func() error { return s.BalanceWatcherLoop(ctx) })
+	}
 
 	return pg.Wait()
 }
@@ -196,8 +241,15 @@ func (s *PeggyOrchestrator) startRelayerMode(ctx context.Context) error {
 
 	var pg loops.ParanoidGroup
 
+	pg.Go(func() error { return s.TokenMappingLoop(ctx) })
 	pg.Go(func() error { return s.BatchRequesterLoop(ctx) })
 	pg.Go(func() error { return s.RelayerMainLoop(ctx) })
+	pg.Go(func() error { return s.CheckpointVerifierLoop(ctx) })
+	pg.Go(func() error { return s.BridgeMetricsLoop(ctx, false) })
+
+	if s.balanceWatcher != nil {
+		pg.Go(func() error { return s.BalanceWatcherLoop(ctx) })
+	}
 
 	return pg.Wait()
 }
@@ -0,0 +1,82 @@
+package pricefeed
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+const (
+	maxRespTime  = 15 * time.Second
+	maxRespBytes = 10 * 1024 * 1024
+
+	contractPlaceholder = "{contract}"
+)
+
+type httpSource struct {
+	client      *http.Client
+	urlTemplate string
+	field       string
+}
+
+// NewHTTPSource returns a price source that reads a USD price from a JSON API. The {contract}
+// placeholder in the URL and in the dot-separated field path is replaced by the lowercase token
+// address, e.g. "https://api.example.com/price?token={contract}" with field "data.usd".
+func NewHTTPSource(urlTemplate, field string) PriceSource {
+	return &httpSource{
+		client:      &http.Client{Timeout: maxRespTime},
+		urlTemplate: urlTemplate,
+		field:       field,
+	}
+}
+
+func (s *httpSource) QueryUSDPrice(erc20Contract common.Address) (float64, error) {
+	contract := strings.ToLower(erc20Contract.Hex())
+	reqURL := strings.ReplaceAll(s.urlTemplate, contractPlaceholder, contract)
+
+	resp, err := s.client.Get(reqURL)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to fetch price from %s", reqURL)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, errors.Errorf("%s returned status %d", reqURL, resp.StatusCode)
+	}
+
+	var body interface{}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxRespBytes)).Decode(&body); err != nil {
+		return 0, errors.Wrapf(err, "failed to decode response from %s", reqURL)
+	}
+
+	field := strings.ReplaceAll(s.field, contractPlaceholder, contract)
+	value := body
+	for _, key := range strings.Split(field, ".") {
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			return 0, errors.Errorf("response has no field %s", field)
+		}
+
+		value = obj[key]
+	}
+
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case string:
+		price, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return 0, errors.Wrapf(err, "invalid price in field %s", field)
+		}
+
+		return price, nil
+	default:
+		return 0, errors.Errorf("field %s is not a number", field)
+	}
+}
@@ -0,0 +1,306 @@
+package pricefeed
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	log "github.com/xlab/suplog"
+
+	"github.com/InjectiveLabs/peggo/orchestrator/metrics"
+)
+
+// PriceSource is a single provider of token prices, e.g. CoinGecko.
+type PriceSource interface {
+	QueryUSDPrice(erc20Contract common.Address) (float64, error)
+}
+
+// Source is a named price source.
+type Source struct {
+	Name string
+	PriceSource
+}
+
+type Config struct {
+	// CacheTTL is how long an aggregated price is served without querying the sources.
+	CacheTTL time.Duration
+
+	// MaxStaleness is how old the last good price may be to be used when all sources fail.
+	MaxStaleness time.Duration
+
+	// MaxDeviation is the max relative deviation from the median, prices further away are rejected as outliers.
+	// 0 disables the check.
+	MaxDeviation float64
+
+	// Primary is the name of the source trusted when only two sources answer and they disagree.
+	// The first source is the primary if it's empty.
+	Primary string
+}
+
+func checkConfig(cfg *Config) *Config {
+	if cfg == nil {
+		cfg = &Config{}
+	}
+
+	if cfg.CacheTTL == 0 {
+		cfg.CacheTTL = time.Minute
+	}
+
+	if cfg.MaxStaleness == 0 {
+		cfg.MaxStaleness = 30 * time.Minute
+	}
+
+	return cfg
+}
+
+type cachedPrice struct {
+	price     float64
+	fetchedAt time.Time
+}
+
+// AggregatedPriceFeed queries all sources and returns the median of the prices
+// that agree with each other. Prices are cached, and the last good price is used
+// as a fallback while it's not older than MaxStaleness.
+type AggregatedPriceFeed struct {
+	config  *Config
+	sources []Source
+
+	mux    sync.Mutex
+	prices map[common.Address]cachedPrice
+
+	logger  log.Logger
+	svcTags metrics.Tags
+}
+
+func NewAggregatedPriceFeed(cfg *Config, sources ...Source) (*AggregatedPriceFeed, error) {
+	if len(sources) == 0 {
+		return nil, errors.New("no price sources configured")
+	}
+
+	cfg = checkConfig(cfg)
+
+	names := make([]string, 0, len(sources))
+	for _, src := range sources {
+		names = append(names, src.Name)
+	}
+
+	if len(cfg.Primary) == 0 {
+		cfg.Primary = sources[0].Name
+	} else if !containsName(names, cfg.Primary) {
+		return nil, errors.Errorf("primary price source %s is not configured", cfg.Primary)
+	}
+
+	log.WithFields(log.Fields{"sources": names, "primary": cfg.Primary}).Infoln("using aggregated price feed")
+
+	return &AggregatedPriceFeed{
+		config:  cfg,
+		sources: sources,
+		prices:  make(map[common.Address]cachedPrice),
+		logger:  log.WithField("svc", "price_feed"),
+		svcTags: metrics.Tags{
+			"svc": "price_feed",
+		},
+	}, nil
+}
+
+func (f *AggregatedPriceFeed) QueryUSDPrice(erc20Contract common.Address) (float64, error) {
+	metrics.ReportFuncCall(f.svcTags)
+	doneFn := metrics.ReportFuncTiming(f.svcTags)
+	defer doneFn()
+
+	f.mux.Lock()
+	cached, ok := f.prices[erc20Contract]
+	f.mux.Unlock()
+
+	if ok && time.Since(cached.fetchedAt) < f.config.CacheTTL {
+		return cached.price, nil
+	}
+
+	price, err := f.aggregate(erc20Contract)
+	if err != nil {
+		if ok && time.Since(cached.fetchedAt) < f.config.MaxStaleness {
+			f.logger.WithError(err).WithFields(log.Fields{
+				"token_contract": erc20Contract.Hex(),
+				"price":          cached.price,
+				"fetched_at":     cached.fetchedAt,
+			}).Warningln("all price sources failed, using last known price")
+
+			return cached.price, nil
+		}
+
+		metrics.ReportFuncError(f.svcTags)
+		return 0, errors.Wrapf(err, "no price available for %s", erc20Contract.Hex())
+	}
+
+	f.mux.Lock()
+	f.prices[erc20Contract] = cachedPrice{price: price, fetchedAt: time.Now()}
+	f.mux.Unlock()
+
+	return price, nil
+}
+
+// aggregate queries all sources in parallel and returns the median of non-outlier prices.
+func (f *AggregatedPriceFeed) aggregate(erc20Contract common.Address) (float64, error) {
+	var (
+		wg     sync.WaitGroup
+		prices = make([]float64, len(f.sources))
+	)
+
+	for i, src := range f.sources {
+		if !supportsToken(src, erc20Contract) {
+			continue
+		}
+
+		wg.Add(1)
+
+		go func(i int, src Source) {
+			defer wg.Done()
+			prices[i] = f.querySource(src, erc20Contract)
+		}(i, src)
+	}
+
+	wg.Wait()
+
+	valid := make([]float64, 0, len(prices))
+	for _, p := range prices {
+		if p > 0 {
+			valid = append(valid, p)
+		}
+	}
+
+	if len(valid) == 0 {
+		return 0, errors.New("all price sources failed")
+	}
+
+	med := median(valid)
+	accepted := valid[:0:0]
+	for i, p := range prices {
+		if p <= 0 {
+			continue
+		}
+
+		if f.config.MaxDeviation > 0 && deviation(p, med) > f.config.MaxDeviation {
+			metrics.ReportFuncError(sourceTags(f.sources[i].Name))
+			f.logger.WithFields(log.Fields{
+				"source":         f.sources[i].Name,
+				"token_contract": erc20Contract.Hex(),
+				"price":          p,
+				"median":         med,
+			}).Warningln("rejecting outlier price")
+
+			continue
+		}
+
+		accepted = append(accepted, p)
+	}
+
+	if len(accepted) == 0 {
+		// two prices can't outvote each other, trust the primary
+		if len(valid) == 2 {
+			for i, p := range prices {
+				if p > 0 && f.sources[i].Name == f.config.Primary {
+					f.logger.WithFields(log.Fields{
+						"source":         f.sources[i].Name,
+						"token_contract": erc20Contract.Hex(),
+						"price":          p,
+					}).Warningln("price sources disagree, using the primary source")
+
+					return p, nil
+				}
+			}
+		}
+
+		return 0, errors.Errorf("price sources disagree by more than %.1f%%", f.config.MaxDeviation*100)
+	}
+
+	return median(accepted), nil
+}
+
+// querySource returns the price reported by the source, or 0 if it failed.
+func (f *AggregatedPriceFeed) querySource(src Source, erc20Contract common.Address) float64 {
+	tags := sourceTags(src.Name)
+
+	metrics.ReportFuncCall(tags)
+	doneFn := metrics.ReportFuncTiming(tags)
+	defer doneFn()
+
+	price, err := src.QueryUSDPrice(erc20Contract)
+	metrics.ReportPriceSource(src.Name, err == nil && price > 0)
+
+	if err != nil {
+		metrics.ReportFuncError(tags)
+		f.logger.WithError(err).WithFields(log.Fields{
+			"source":         src.Name,
+			"token_contract": erc20Contract.Hex(),
+		}).Warningln("failed to query price source")
+
+		return 0
+	}
+
+	if price <= 0 {
+		metrics.ReportFuncError(tags)
+		f.logger.WithFields(log.Fields{
+			"source":         src.Name,
+			"token_contract": erc20Contract.Hex(),
+			"price":          price,
+		}).Warningln("price source returned a non-positive price")
+
+		return 0
+	}
+
+	return price
+}
+
+// tokenFilter is implemented by sources that only price some tokens, e.g. those with a configured Chainlink feed.
+type tokenFilter interface {
+	SupportsToken(erc20Contract common.Address) bool
+}
+
+func supportsToken(src Source, erc20Contract common.Address) bool {
+	if filter, ok := src.PriceSource.(tokenFilter); ok {
+		return filter.SupportsToken(erc20Contract)
+	}
+
+	return true
+}
+
+func sourceTags(name string) metrics.Tags {
+	return metrics.Tags{
+		"svc":    "price_feed",
+		"source": name,
+	}
+}
+
+func containsName(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func median(prices []float64) float64 {
+	sorted := make([]float64, len(prices))
+	copy(sorted, prices)
+	sort.Float64s(sorted)
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+
+	return sorted[mid]
+}
+
+func deviation(price, reference float64) float64 {
+	d := (price - reference) / reference
+	if d < 0 {
+		return -d
+	}
+
+	return d
+}
@@ -0,0 +1,210 @@
+package pricefeed
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockFilteredSource struct {
+	*mockSource
+	supported bool
+}
+
+func (s mockFilteredSource) SupportsToken(common.Address) bool {
+	return s.supported
+}
+
+type mockSource struct {
+	mux   sync.Mutex
+	price float64
+	err   error
+	calls int
+}
+
+func (s *mockSource) QueryUSDPrice(common.Address) (float64, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.calls++
+	return s.price, s.err
+}
+
+func (s *mockSource) set(price float64, err error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.price, s.err = price, err
+}
+
+var testToken = common.HexToAddress("0xe28b3B32B6c345A34Ff64674606124Dd5Aceca30")
+
+func TestAggregatedPriceFeed(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no sources", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewAggregatedPriceFeed(nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("median of sources", func(t *testing.T) {
+		t.Parallel()
+
+		feed, err := NewAggregatedPriceFeed(&Config{MaxDeviation: 0.5},
+			Source{Name: "a", PriceSource: &mockSource{price: 10}},
+			Source{Name: "b", PriceSource: &mockSource{price: 11}},
+			Source{Name: "c", PriceSource: &mockSource{err: errors.New("down")}},
+			Source{Name: "d", PriceSource: &mockSource{price: 12}},
+		)
+		assert.NoError(t, err)
+
+		price, err := feed.QueryUSDPrice(testToken)
+		assert.NoError(t, err)
+		assert.Equal(t, 11.0, price)
+	})
+
+	t.Run("outliers are rejected", func(t *testing.T) {
+		t.Parallel()
+
+		feed, err := NewAggregatedPriceFeed(&Config{MaxDeviation: 0.1},
+			Source{Name: "a", PriceSource: &mockSource{price: 10}},
+			Source{Name: "b", PriceSource: &mockSource{price: 10.5}},
+			Source{Name: "c", PriceSource: &mockSource{price: 1000}},
+		)
+		assert.NoError(t, err)
+
+		price, err := feed.QueryUSDPrice(testToken)
+		assert.NoError(t, err)
+		assert.Equal(t, 10.25, price)
+	})
+
+	t.Run("deviation check is disabled with 0", func(t *testing.T) {
+		t.Parallel()
+
+		feed, err := NewAggregatedPriceFeed(&Config{},
+			Source{Name: "a", PriceSource: &mockSource{price: 10}},
+			Source{Name: "b", PriceSource: &mockSource{price: 20}},
+			Source{Name: "c", PriceSource: &mockSource{price: 1000}},
+		)
+		assert.NoError(t, err)
+
+		price, err := feed.QueryUSDPrice(testToken)
+		assert.NoError(t, err)
+		assert.Equal(t, 20.0, price)
+	})
+
+	t.Run("primary is used when two sources disagree", func(t *testing.T) {
+		t.Parallel()
+
+		feed, err := NewAggregatedPriceFeed(&Config{MaxDeviation: 0.1, Primary: "b"},
+			Source{Name: "a", PriceSource: &mockSource{price: 10}},
+			Source{Name: "b", PriceSource: &mockSource{price: 15}},
+		)
+		assert.NoError(t, err)
+
+		price, err := feed.QueryUSDPrice(testToken)
+		assert.NoError(t, err)
+		assert.Equal(t, 15.0, price)
+
+		_, err = NewAggregatedPriceFeed(&Config{Primary: "c"}, Source{Name: "a", PriceSource: &mockSource{price: 10}})
+		assert.Error(t, err)
+	})
+
+	t.Run("sources without the token are skipped", func(t *testing.T) {
+		t.Parallel()
+
+		unsupported := mockFilteredSource{mockSource: &mockSource{price: 100}}
+		feed, err := NewAggregatedPriceFeed(nil,
+			Source{Name: "a", PriceSource: &mockSource{price: 10}},
+			Source{Name: "b", PriceSource: unsupported},
+		)
+		assert.NoError(t, err)
+
+		price, err := feed.QueryUSDPrice(testToken)
+		assert.NoError(t, err)
+		assert.Equal(t, 10.0, price)
+		assert.Equal(t, 0, unsupported.calls)
+	})
+
+	t.Run("prices are cached", func(t *testing.T) {
+		t.Parallel()
+
+		src := &mockSource{price: 5}
+		feed, err := NewAggregatedPriceFeed(&Config{CacheTTL: time.Hour}, Source{Name: "a", PriceSource: src})
+		assert.NoError(t, err)
+
+		for i := 0; i < 3; i++ {
+			price, err := feed.QueryUSDPrice(testToken)
+			assert.NoError(t, err)
+			assert.Equal(t, 5.0, price)
+		}
+
+		assert.Equal(t, 1, src.calls)
+	})
+
+	t.Run("last good price is used within staleness bound", func(t *testing.T) {
+		t.Parallel()
+
+		src := &mockSource{price: 5}
+		feed, err := NewAggregatedPriceFeed(&Config{CacheTTL: time.Nanosecond, MaxStaleness: time.Hour}, Source{Name: "a", PriceSource: src})
+		assert.NoError(t, err)
+
+		_, err = feed.QueryUSDPrice(testToken)
+		assert.NoError(t, err)
+
+		src.set(0, errors.New("down"))
+
+		price, err := feed.QueryUSDPrice(testToken)
+		assert.NoError(t, err)
+		assert.Equal(t, 5.0, price)
+	})
+
+	t.Run("stale price is not used", func(t *testing.T) {
+		t.Parallel()
+
+		src := &mockSource{price: 5}
+		feed, err := NewAggregatedPriceFeed(&Config{CacheTTL: time.Nanosecond, MaxStaleness: time.Millisecond}, Source{Name: "a", PriceSource: src})
+		assert.NoError(t, err)
+
+		_, err = feed.QueryUSDPrice(testToken)
+		assert.NoError(t, err)
+
+		src.set(0, errors.New("down"))
+		time.Sleep(5 * time.Millisecond)
+
+		_, err = feed.QueryUSDPrice(testToken)
+		assert.Error(t, err)
+	})
+}
+
+func TestHTTPSource(t *testing.T) {
+	t.Parallel()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "0xe28b3b32b6c345a34ff64674606124dd5aceca30", r.URL.Query().Get("contract_addresses"))
+		_, _ = w.Write([]byte(`{"0xe28b3b32b6c345a34ff64674606124dd5aceca30":{"usd":9.35,"usd_str":"9.36"}}`))
+	}))
+	defer ts.Close()
+
+	src := NewHTTPSource(ts.URL+"/?contract_addresses={contract}", "{contract}.usd")
+	price, err := src.QueryUSDPrice(testToken)
+	assert.NoError(t, err)
+	assert.Equal(t, 9.35, price)
+
+	src = NewHTTPSource(ts.URL+"/?contract_addresses={contract}", "{contract}.usd_str")
+	price, err = src.QueryUSDPrice(testToken)
+	assert.NoError(t, err)
+	assert.Equal(t, 9.36, price)
+
+	src = NewHTTPSource(ts.URL+"/?contract_addresses={contract}", "{contract}.eur")
+	_, err = src.QueryUSDPrice(testToken)
+	assert.Error(t, err)
+}
@@ -2,15 +2,18 @@ package orchestrator
 
 import (
 	"context"
+	"math/big"
 	"sort"
 	"time"
 
 	"github.com/avast/retry-go"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
 	log "github.com/xlab/suplog"
 
+	"github.com/InjectiveLabs/peggo/orchestrator/alerts"
 	"github.com/InjectiveLabs/peggo/orchestrator/ethereum/util"
 	"github.com/InjectiveLabs/peggo/orchestrator/loops"
 	wrappers "github.com/InjectiveLabs/peggo/solidity/wrappers/Peggy.sol"
@@ -25,10 +28,12 @@ func (s *PeggyOrchestrator) RelayerMainLoop(ctx context.Context) (err error) {
 		relayBatchOffsetDur:  s.relayBatchOffsetDur,
 		valsetRelaying:       s.valsetRelayEnabled,
 		batchRelaying:        s.batchRelayEnabled,
+		balance:              s.balanceWatcher,
 	}
 
 	return loops.RunLoop(
 		ctx,
+		"Relayer",
 		defaultLoopDur,
 		func() error { return rel.run(ctx, s.injective, s.ethereum) },
 	)
@@ -41,6 +46,9 @@ type relayer struct {
 	relayBatchOffsetDur  time.Duration
 	valsetRelaying       bool
 	batchRelaying        bool
+
+	// balance pauses relaying while the account can't pay for it, nil if not watched
+	balance *balanceWatcher
 }
 
 func (r *relayer) run(
@@ -48,8 +56,16 @@ func (r *relayer) run(
 	injective InjectiveNetwork,
 	ethereum EthereumNetwork,
 ) error {
+	if r.balance.Paused() {
+		r.log.Warningln("relayer account balance is too low, relaying paused")
+		return nil
+	}
+
 	var pg loops.ParanoidGroup
 
+	// retrying can't help without funds
+	canRetry := retry.RetryIf(func(err error) bool { return !isInsufficientFundsErr(err) })
+
 	if r.valsetRelaying {
 		r.log.Infoln("scanning Injective for confirmed valset updates")
 		pg.Go(func() error {
@@ -59,7 +75,9 @@ func (r *relayer) run(
 				retry.Attempts(r.retries),
 				retry.OnRetry(func(n uint, err error) {
 					r.log.WithError(err).Warningf("failed to relay valsets, will retry (%d)", n)
+					loops.ReportError("Relayer", err)
 				}),
+				canRetry,
 			)
 		})
 	}
@@ -73,14 +91,26 @@ func (r *relayer) run(
 				retry.Attempts(r.retries),
 				retry.OnRetry(func(n uint, err error) {
 					r.log.WithError(err).Warningf("failed to relay batches, will retry (%d)", n)
+					loops.ReportError("Relayer", err)
 				}),
+				canRetry,
 			)
 		})
 	}
 
 	if pg.Initialized() {
 		if err := pg.Wait(); err != nil {
+			if r.balance != nil && isInsufficientFundsErr(err) {
+				// keep the orchestrator running, the balance watcher resumes relaying once topped up
+				r.balance.pause()
+				r.log.WithError(err).Errorln("relayer account can't pay for relaying, relaying paused")
+				return nil
+			}
+
 			r.log.WithError(err).Errorln("got error, loop exits")
+			alerts.Critical("relayer_failed", "Relayer failed to relay to Ethereum and exits", map[string]interface{}{
+				"error": err.Error(),
+			})
 			return err
 		}
 	}
@@ -175,6 +205,7 @@ func (r *relayer) relayValsets(
 		return err
 	}
 
+	r.balance.trackRelay(*txHash)
 	r.log.WithField("tx_hash", txHash.Hex()).Infoln("updated valset on Ethereum")
 
 	return nil
@@ -270,6 +301,7 @@ func (r *relayer) relayBatches(
 		return err
 	}
 
+	r.balance.trackRelay(*txHash)
 	r.log.WithField("tx_hash", txHash.Hex()).Infoln("sent batch tx to Ethereum")
 
 	return nil
@@ -277,15 +309,35 @@ func (r *relayer) relayBatches(
 
 const valsetBlocksToSearch = 2000
 
-// FindLatestValset finds the latest valset on the Peggy contract by looking back through the event
+// InjectiveValsetSource is the part of InjectiveNetwork needed to find the valset on Ethereum.
+type InjectiveValsetSource interface {
+	ValsetAt(ctx context.Context, nonce uint64) (*types.Valset, error)
+}
+
+// EthereumValsetSource is the part of EthereumNetwork needed to find the valset on Ethereum.
+type EthereumValsetSource interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*ethtypes.Header, error)
+	GetValsetNonce(ctx context.Context) (*big.Int, error)
+	GetValsetUpdatedEvents(startBlock, endBlock uint64) ([]*wrappers.PeggyValsetUpdatedEvent, error)
+}
+
+func (r *relayer) findLatestValsetOnEth(
+	ctx context.Context,
+	injective InjectiveNetwork,
+	ethereum EthereumNetwork,
+) (*types.Valset, error) {
+	return FindLatestValsetOnEth(ctx, injective, ethereum)
+}
+
+// FindLatestValsetOnEth finds the latest valset on the Peggy contract by looking back through the event
 // history and finding the most recent ValsetUpdatedEvent. Most of the time this will be very fast
 // as the latest update will be in recent blockchain history and the search moves from the present
 // backwards in time. In the case that the validator set has not been updated for a very long time
 // this will take longer.
-func (r *relayer) findLatestValsetOnEth(
+func FindLatestValsetOnEth(
 	ctx context.Context,
-	injective InjectiveNetwork,
-	ethereum EthereumNetwork,
+	injective InjectiveValsetSource,
+	ethereum EthereumValsetSource,
 ) (*types.Valset, error) {
 	latestHeader, err := ethereum.HeaderByNumber(ctx, nil)
 	if err != nil {
@@ -312,7 +364,7 @@ func (r *relayer) findLatestValsetOnEth(
 			startSearchBlock = currentBlock - valsetBlocksToSearch
 		}
 
-		r.log.WithFields(log.Fields{
+		log.WithFields(log.Fields{
 			"block_start": startSearchBlock,
 			"block_end":   currentBlock,
 		}).Debugln("looking for the most recent ValsetUpdatedEvent on Ethereum")
@@ -386,36 +438,52 @@ func checkIfValsetsDiffer(cosmosValset, ethereumValset *types.Valset) {
 			"eth_valset_nonce",
 			ethereumValset.Nonce,
 		).Errorln("Cosmos does not have a valset for nonce from Ethereum chain. Possible bridge hijacking!")
+		alerts.Critical("valset_mismatch", "Cosmos does not have a valset for nonce from Ethereum chain. Possible bridge hijacking!", map[string]interface{}{
+			"eth_valset_nonce": ethereumValset.Nonce,
+		})
 		return
 	}
 
 	if cosmosValset.Nonce != ethereumValset.Nonce {
-		log.WithFields(log.Fields{
+		fields := log.Fields{
 			"cosmos_valset_nonce": cosmosValset.Nonce,
 			"eth_valset_nonce":    ethereumValset.Nonce,
-		}).Errorln("Cosmos does have a wrong valset nonce, differs from Ethereum chain. Possible bridge hijacking!")
+		}
+		log.WithFields(fields).Errorln("Cosmos does have a wrong valset nonce, differs from Ethereum chain. Possible bridge hijacking!")
+		alerts.Critical("valset_mismatch", "Cosmos does have a wrong valset nonce, differs from Ethereum chain. Possible bridge hijacking!", fields)
 		return
 	}
 
 	if len(cosmosValset.Members) != len(ethereumValset.Members) {
-		log.WithFields(log.Fields{
+		fields := log.Fields{
 			"cosmos_valset": len(cosmosValset.Members),
 			"eth_valset":    len(ethereumValset.Members),
-		}).Errorln("Cosmos and Ethereum Valsets have different length. Possible bridge hijacking!")
+		}
+		log.WithFields(fields).Errorln("Cosmos and Ethereum Valsets have different length. Possible bridge hijacking!")
+		alerts.Critical("valset_mismatch", "Cosmos and Ethereum Valsets have different length. Possible bridge hijacking!", fields)
 		return
 	}
 
 	BridgeValidators(cosmosValset.Members).Sort()
 	BridgeValidators(ethereumValset.Members).Sort()
 
+	sortingErr := false
 	for idx, member := range cosmosValset.Members {
 		if ethereumValset.Members[idx].EthereumAddress != member.EthereumAddress {
 			log.Errorln("Valsets are different, a sorting error?")
+			sortingErr = true
 		}
 		if ethereumValset.Members[idx].Power != member.Power {
 			log.Errorln("Valsets are different, a sorting error?")
+			sortingErr = true
 		}
 	}
+
+	if sortingErr {
+		alerts.Warning("valset_sorting", "Valsets are different, a sorting error?", map[string]interface{}{
+			"valset_nonce": cosmosValset.Nonce,
+		})
+	}
 }
 
 type BridgeValidators []*types.BridgeValidator
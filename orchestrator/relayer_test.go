@@ -1150,3 +1150,95 @@ func TestBatchRelaying(t *testing.T) {
 		assert.NoError(t, rel.relayBatches(context.TODO(), inj, eth))
 	})
 }
+
+func TestFindLatestValsetOnEth(t *testing.T) {
+	t.Parallel()
+
+	validators := []common.Address{
+		common.HexToAddress("0x1111111111111111111111111111111111111111"),
+		common.HexToAddress("0x2222222222222222222222222222222222222222"),
+	}
+
+	t.Run("latest valset update is found in an earlier block range", func(t *testing.T) {
+		t.Parallel()
+
+		inj := &mockInjective{
+			valsetAtFn: func(_ context.Context, nonce uint64) (*types.Valset, error) {
+				assert.Equal(t, uint64(7), nonce)
+				return &types.Valset{Nonce: 7}, nil
+			},
+		}
+
+		var searched [][2]uint64
+		eth := mockEthereum{
+			headerByNumberFn: func(_ context.Context, _ *big.Int) (*ctypes.Header, error) {
+				return &ctypes.Header{Number: big.NewInt(4500)}, nil
+			},
+			getValsetNonceFn: func(_ context.Context) (*big.Int, error) {
+				return big.NewInt(7), nil
+			},
+			getValsetUpdatedEventsFn: func(start uint64, end uint64) ([]*wrappers.PeggyValsetUpdatedEvent, error) {
+				searched = append(searched, [2]uint64{start, end})
+				if start != 500 {
+					return nil, nil
+				}
+
+				return []*wrappers.PeggyValsetUpdatedEvent{
+					{
+						NewValsetNonce: big.NewInt(6),
+						RewardAmount:   big.NewInt(0),
+						Validators:     validators[:1],
+						Powers:         []*big.Int{big.NewInt(4000)},
+					},
+					{
+						NewValsetNonce: big.NewInt(7),
+						RewardAmount:   big.NewInt(1000),
+						RewardToken:    common.HexToAddress("0xfafafafafafafafa"),
+						Validators:     validators,
+						Powers:         []*big.Int{big.NewInt(3000), big.NewInt(1000)},
+					},
+				}, nil
+			},
+		}
+
+		valset, err := FindLatestValsetOnEth(context.TODO(), inj, eth)
+		assert.NoError(t, err)
+		assert.Equal(t, [][2]uint64{{2500, 4500}, {500, 2500}}, searched)
+
+		assert.Equal(t, uint64(7), valset.Nonce)
+		assert.Equal(t, "1000", valset.RewardAmount.String())
+		assert.Equal(t, common.HexToAddress("0xfafafafafafafafa").Hex(), valset.RewardToken)
+		assert.Equal(t, []*types.BridgeValidator{
+			{EthereumAddress: validators[0].Hex(), Power: 3000},
+			{EthereumAddress: validators[1].Hex(), Power: 1000},
+		}, valset.Members)
+	})
+
+	t.Run("no valset update down to the genesis block", func(t *testing.T) {
+		t.Parallel()
+
+		inj := &mockInjective{
+			valsetAtFn: func(_ context.Context, _ uint64) (*types.Valset, error) {
+				return &types.Valset{Nonce: 7}, nil
+			},
+		}
+
+		var searched [][2]uint64
+		eth := mockEthereum{
+			headerByNumberFn: func(_ context.Context, _ *big.Int) (*ctypes.Header, error) {
+				return &ctypes.Header{Number: big.NewInt(3000)}, nil
+			},
+			getValsetNonceFn: func(_ context.Context) (*big.Int, error) {
+				return big.NewInt(7), nil
+			},
+			getValsetUpdatedEventsFn: func(start uint64, end uint64) ([]*wrappers.PeggyValsetUpdatedEvent, error) {
+				searched = append(searched, [2]uint64{start, end})
+				return nil, nil
+			},
+		}
+
+		_, err := FindLatestValsetOnEth(context.TODO(), inj, eth)
+		assert.Equal(t, ErrNotFound, err)
+		assert.Equal(t, [][2]uint64{{1000, 3000}, {0, 1000}}, searched)
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	log "github.com/xlab/suplog"
 
+	"github.com/InjectiveLabs/peggo/orchestrator/alerts"
 	"github.com/InjectiveLabs/peggo/orchestrator/cosmos"
 	"github.com/InjectiveLabs/peggo/orchestrator/loops"
 	"github.com/InjectiveLabs/sdk-go/chain/peggy/types"
@@ -24,12 +25,13 @@ func (s *PeggyOrchestrator) EthSignerMainLoop(ctx context.Context) error {
 	signer := &ethSigner{
 		log:     log.WithField("loop", "EthSigner"),
 		peggyID: peggyID,
-		ethFrom: s.ethereum.FromAddress(),
+		ethFrom: s.ethSignerAddr,
 		retries: s.maxAttempts,
 	}
 
 	return loops.RunLoop(
 		ctx,
+		"EthSigner",
 		defaultLoopDur,
 		func() error { return signer.run(ctx, s.injective) },
 	)
@@ -69,16 +71,27 @@ func (s *ethSigner) run(ctx context.Context, injective InjectiveNetwork) error {
 	s.log.Infoln("scanning Injective for unconfirmed batches and valset updates")
 
 	if err := s.signNewValsetUpdates(ctx, injective); err != nil {
+		s.alertFailed(err)
 		return err
 	}
 
 	if err := s.signNewBatches(ctx, injective); err != nil {
+		s.alertFailed(err)
 		return err
 	}
 
 	return nil
 }
 
+// alertFailed raises an alert when the signer exits, unsigned valsets and batches
+// eventually get the validator slashed.
+func (s *ethSigner) alertFailed(err error) {
+	alerts.Critical("signer_failed", "EthSigner failed to confirm on Injective and exits, the validator may get slashed", map[string]interface{}{
+		"eth_from": s.ethFrom.Hex(),
+		"error":    err.Error(),
+	})
+}
+
 func (s *ethSigner) signNewBatches(ctx context.Context, injective InjectiveNetwork) error {
 	oldestUnsignedTransactionBatch, err := s.getUnsignedBatch(ctx, injective)
 	if err != nil {
@@ -114,6 +127,7 @@ func (s *ethSigner) getUnsignedBatch(ctx context.Context, injective InjectiveNet
 		retry.Attempts(s.retries),
 		retry.OnRetry(func(n uint, err error) {
 			s.log.WithError(err).Warningf("failed to get unconfirmed batch, will retry (%d)", n)
+			loops.ReportError("EthSigner", err)
 		}),
 	); err != nil {
 		s.log.WithError(err).Errorln("got error, loop exits")
@@ -134,6 +148,7 @@ func (s *ethSigner) signBatch(
 		retry.Attempts(s.retries),
 		retry.OnRetry(func(n uint, err error) {
 			s.log.WithError(err).Warningf("failed to confirm batch on Injective, will retry (%d)", n)
+			loops.ReportError("EthSigner", err)
 		}),
 	); err != nil {
 		s.log.WithError(err).Errorln("got error, loop exits")
@@ -184,6 +199,7 @@ func (s *ethSigner) getUnsignedValsets(ctx context.Context, injective InjectiveN
 		retry.Attempts(s.retries),
 		retry.OnRetry(func(n uint, err error) {
 			s.log.WithError(err).Warningf("failed to get unconfirmed valset updates, will retry (%d)", n)
+			loops.ReportError("EthSigner", err)
 		}),
 	); err != nil {
 		s.log.WithError(err).Errorln("got error, loop exits")
@@ -204,6 +220,7 @@ func (s *ethSigner) signValset(
 		retry.Attempts(s.retries),
 		retry.OnRetry(func(n uint, err error) {
 			s.log.WithError(err).Warningf("failed to confirm valset update on Injective, will retry (%d)", n)
+			loops.ReportError("EthSigner", err)
 		}),
 	); err != nil {
 		s.log.WithError(err).Errorln("got error, loop exits")
@@ -0,0 +1,117 @@
+package orchestrator
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	eth "github.com/ethereum/go-ethereum/common"
+	log "github.com/xlab/suplog"
+
+	"github.com/InjectiveLabs/peggo/orchestrator/loops"
+)
+
+const tokenMappingRefreshDur = 5 * time.Minute
+
+// TokenMapping maps ERC20 contracts to their Injective denoms. Mappings of Cosmos-originated
+// tokens are refreshed from the chain, while static mappings (from flags or config) always win.
+type TokenMapping struct {
+	mux    sync.RWMutex
+	static map[eth.Address]string
+	chain  map[eth.Address]string
+}
+
+func NewTokenMapping(static map[eth.Address]string) *TokenMapping {
+	m := &TokenMapping{
+		static: make(map[eth.Address]string, len(static)),
+		chain:  make(map[eth.Address]string),
+	}
+
+	for addr, denom := range static {
+		m.static[addr] = denom
+	}
+
+	return m
+}
+
+// Denom returns the Injective denom of the token, if it's known.
+func (m *TokenMapping) Denom(tokenAddr eth.Address) (string, bool) {
+	if m == nil {
+		return "", false
+	}
+
+	m.mux.RLock()
+	defer m.mux.RUnlock()
+
+	if denom, ok := m.static[tokenAddr]; ok {
+		return denom, true
+	}
+
+	denom, ok := m.chain[tokenAddr]
+	return denom, ok
+}
+
+// SetChainMapping replaces the mappings read from the chain.
+func (m *TokenMapping) SetChainMapping(chain map[eth.Address]string) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	m.chain = chain
+}
+
+// Len returns the number of mapped tokens.
+func (m *TokenMapping) Len() int {
+	m.mux.RLock()
+	defer m.mux.RUnlock()
+
+	n := len(m.chain)
+	for addr := range m.static {
+		if _, ok := m.chain[addr]; !ok {
+			n++
+		}
+	}
+
+	return n
+}
+
+// TokenMappingLoop keeps the ERC20 to denom mapping in sync with Injective, so that tokens
+// deployed through ERC20DeployedEvent are picked up without a restart.
+func (s *PeggyOrchestrator) TokenMappingLoop(ctx context.Context) error {
+	logger := log.WithField("loop", "TokenMapping")
+
+	return loops.RunLoop(
+		ctx,
+		"TokenMapping",
+		tokenMappingRefreshDur,
+		func() error {
+			if err := s.refreshTokenMapping(ctx); err != nil {
+				// non-fatal, the previous mapping is kept
+				logger.WithError(err).Warningln("unable to refresh ERC20 token mapping from Injective")
+			}
+
+			return nil
+		},
+	)
+}
+
+func (s *PeggyOrchestrator) refreshTokenMapping(ctx context.Context) error {
+	mappings, err := s.injective.ERC20ToDenoms(ctx)
+	if err != nil {
+		return err
+	}
+
+	chain := make(map[eth.Address]string, len(mappings))
+	for _, mapping := range mappings {
+		if mapping == nil || !eth.IsHexAddress(mapping.Erc20) || len(mapping.Denom) == 0 {
+			continue
+		}
+
+		chain[eth.HexToAddress(mapping.Erc20)] = mapping.Denom
+	}
+
+	s.erc20ContractMapping.SetChainMapping(chain)
+
+	log.WithField("tokens", s.erc20ContractMapping.Len()).Debugln("refreshed ERC20 token mapping")
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	eth "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+
+	peggy "github.com/InjectiveLabs/sdk-go/chain/peggy/types"
+)
+
+func TestTokenMapping(t *testing.T) {
+	t.Parallel()
+
+	var (
+		injToken  = eth.HexToAddress("0xe28b3B32B6c345A34Ff64674606124Dd5Aceca30")
+		atomToken = eth.HexToAddress("0x8D983cb9388EaC77af0474fA441C4815500Cb7BB")
+		newToken  = eth.HexToAddress("0x0000000000000000000000000000000000000042")
+	)
+
+	t.Run("nil mapping", func(t *testing.T) {
+		t.Parallel()
+
+		var m *TokenMapping
+		_, ok := m.Denom(injToken)
+		assert.False(t, ok)
+	})
+
+	t.Run("mapping is refreshed from chain", func(t *testing.T) {
+		t.Parallel()
+
+		orch := &PeggyOrchestrator{
+			erc20ContractMapping: NewTokenMapping(map[eth.Address]string{injToken: "inj"}),
+			injective: &mockInjective{
+				erc20ToDenomsFn: func(context.Context) ([]*peggy.ERC20ToDenom, error) {
+					return []*peggy.ERC20ToDenom{
+						{Erc20: atomToken.Hex(), Denom: "ibc/C4CFF46FD6DE35CA4CF4CE031E643C8FDC9BA4B99AE598E9B0ED98FE3A2319F9"},
+						{Erc20: newToken.Hex(), Denom: "factory/inj1xyz/new"},
+						{Erc20: injToken.Hex(), Denom: "not-inj"},
+						{Erc20: "garbage", Denom: "garbage"},
+					}, nil
+				},
+			},
+		}
+
+		assert.NoError(t, orch.refreshTokenMapping(context.Background()))
+
+		denom, ok := orch.erc20ContractMapping.Denom(newToken)
+		assert.True(t, ok)
+		assert.Equal(t, "factory/inj1xyz/new", denom)
+
+		// static mappings win over the chain
+		denom, ok = orch.erc20ContractMapping.Denom(injToken)
+		assert.True(t, ok)
+		assert.Equal(t, "inj", denom)
+
+		assert.Equal(t, 3, orch.erc20ContractMapping.Len())
+	})
+
+	t.Run("mapping is kept when refresh fails", func(t *testing.T) {
+		t.Parallel()
+
+		m := NewTokenMapping(nil)
+		m.SetChainMapping(map[eth.Address]string{atomToken: "atom"})
+
+		orch := &PeggyOrchestrator{
+			erc20ContractMapping: m,
+			injective: &mockInjective{
+				erc20ToDenomsFn: func(context.Context) ([]*peggy.ERC20ToDenom, error) {
+					return nil, errors.New("fail")
+				},
+			},
+		}
+
+		assert.Error(t, orch.refreshTokenMapping(context.Background()))
+
+		denom, ok := m.Denom(atomToken)
+		assert.True(t, ok)
+		assert.Equal(t, "atom", denom)
+	})
+}
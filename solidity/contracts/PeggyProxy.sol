@@ -0,0 +1,8 @@
+// SPDX-License-Identifier: Apache-2.0
+
+pragma solidity ^0.8.0;
+
+// Peggy is deployed behind a TransparentUpgradeableProxy managed by a ProxyAdmin,
+// this file only pulls them in so that `make gen` builds their Go wrappers.
+import "./@openzeppelin/contracts/ProxyAdmin.sol";
+import "./@openzeppelin/contracts/TransparentUpgradeableProxy.sol";
@@ -0,0 +1,2541 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package wrappers
+
+import (
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// AddressABI is the input ABI used to generate the binding from.
+const AddressABI = "[]"
+
+// AddressBin is the compiled bytecode used for deploying new contracts.
+var AddressBin = "0x60566037600b82828239805160001a607314602a57634e487b7160e01b600052600060045260246000fd5b30600052607381538281f3fe73000000000000000000000000000000000000000030146080604052600080fdfea2646970667358221220dc68e6a0d09f3647bac11c7ebfb393681563f6d3d3a4e76806f5ce450b03995864736f6c63430008150033"
+
+// DeployAddress deploys a new Ethereum contract, binding an instance of Address to it.
+func DeployAddress(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *Address, error) {
+	parsed, err := abi.JSON(strings.NewReader(AddressABI))
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, parsed, common.FromHex(AddressBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &Address{AddressCaller: AddressCaller{contract: contract}, AddressTransactor: AddressTransactor{contract: contract}, AddressFilterer: AddressFilterer{contract: contract}}, nil
+}
+
+// Address is an auto generated Go binding around an Ethereum contract.
+type Address struct {
+	AddressCaller     // Read-only binding to the contract
+	AddressTransactor // Write-only binding to the contract
+	AddressFilterer   // Log filterer for contract events
+}
+
+// AddressCaller is an auto generated read-only Go binding around an Ethereum contract.
+type AddressCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// AddressTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type AddressTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// AddressFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type AddressFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// AddressSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type AddressSession struct {
+	Contract     *Address          // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// AddressCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type AddressCallerSession struct {
+	Contract *AddressCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts  // Call options to use throughout this session
+}
+
+// AddressTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type AddressTransactorSession struct {
+	Contract     *AddressTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts  // Transaction auth options to use throughout this session
+}
+
+// AddressRaw is an auto generated low-level Go binding around an Ethereum contract.
+type AddressRaw struct {
+	Contract *Address // Generic contract binding to access the raw methods on
+}
+
+// AddressCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type AddressCallerRaw struct {
+	Contract *AddressCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// AddressTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type AddressTransactorRaw struct {
+	Contract *AddressTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewAddress creates a new instance of Address, bound to a specific deployed contract.
+func NewAddress(address common.Address, backend bind.ContractBackend) (*Address, error) {
+	contract, err := bindAddress(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Address{AddressCaller: AddressCaller{contract: contract}, AddressTransactor: AddressTransactor{contract: contract}, AddressFilterer: AddressFilterer{contract: contract}}, nil
+}
+
+// NewAddressCaller creates a new read-only instance of Address, bound to a specific deployed contract.
+func NewAddressCaller(address common.Address, caller bind.ContractCaller) (*AddressCaller, error) {
+	contract, err := bindAddress(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &AddressCaller{contract: contract}, nil
+}
+
+// NewAddressTransactor creates a new write-only instance of Address, bound to a specific deployed contract.
+func NewAddressTransactor(address common.Address, transactor bind.ContractTransactor) (*AddressTransactor, error) {
+	contract, err := bindAddress(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &AddressTransactor{contract: contract}, nil
+}
+
+// NewAddressFilterer creates a new log filterer instance of Address, bound to a specific deployed contract.
+func NewAddressFilterer(address common.Address, filterer bind.ContractFilterer) (*AddressFilterer, error) {
+	contract, err := bindAddress(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &AddressFilterer{contract: contract}, nil
+}
+
+// bindAddress binds a generic wrapper to an already deployed contract.
+func bindAddress(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(AddressABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Address *AddressRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Address.Contract.AddressCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Address *AddressRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Address.Contract.AddressTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Address *AddressRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Address.Contract.AddressTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Address *AddressCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Address.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Address *AddressTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Address.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Address *AddressTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Address.Contract.contract.Transact(opts, method, params...)
+}
+
+// ContextABI is the input ABI used to generate the binding from.
+const ContextABI = "[]"
+
+// Context is an auto generated Go binding around an Ethereum contract.
+type Context struct {
+	ContextCaller     // Read-only binding to the contract
+	ContextTransactor // Write-only binding to the contract
+	ContextFilterer   // Log filterer for contract events
+}
+
+// ContextCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ContextCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ContextTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ContextTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ContextFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ContextFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ContextSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ContextSession struct {
+	Contract     *Context          // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// ContextCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ContextCallerSession struct {
+	Contract *ContextCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts  // Call options to use throughout this session
+}
+
+// ContextTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ContextTransactorSession struct {
+	Contract     *ContextTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts  // Transaction auth options to use throughout this session
+}
+
+// ContextRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ContextRaw struct {
+	Contract *Context // Generic contract binding to access the raw methods on
+}
+
+// ContextCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ContextCallerRaw struct {
+	Contract *ContextCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ContextTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ContextTransactorRaw struct {
+	Contract *ContextTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewContext creates a new instance of Context, bound to a specific deployed contract.
+func NewContext(address common.Address, backend bind.ContractBackend) (*Context, error) {
+	contract, err := bindContext(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Context{ContextCaller: ContextCaller{contract: contract}, ContextTransactor: ContextTransactor{contract: contract}, ContextFilterer: ContextFilterer{contract: contract}}, nil
+}
+
+// NewContextCaller creates a new read-only instance of Context, bound to a specific deployed contract.
+func NewContextCaller(address common.Address, caller bind.ContractCaller) (*ContextCaller, error) {
+	contract, err := bindContext(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ContextCaller{contract: contract}, nil
+}
+
+// NewContextTransactor creates a new write-only instance of Context, bound to a specific deployed contract.
+func NewContextTransactor(address common.Address, transactor bind.ContractTransactor) (*ContextTransactor, error) {
+	contract, err := bindContext(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ContextTransactor{contract: contract}, nil
+}
+
+// NewContextFilterer creates a new log filterer instance of Context, bound to a specific deployed contract.
+func NewContextFilterer(address common.Address, filterer bind.ContractFilterer) (*ContextFilterer, error) {
+	contract, err := bindContext(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ContextFilterer{contract: contract}, nil
+}
+
+// bindContext binds a generic wrapper to an already deployed contract.
+func bindContext(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(ContextABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Context *ContextRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Context.Contract.ContextCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Context *ContextRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Context.Contract.ContextTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Context *ContextRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Context.Contract.ContextTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Context *ContextCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Context.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Context *ContextTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Context.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Context *ContextTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Context.Contract.contract.Transact(opts, method, params...)
+}
+
+// ERC1967ProxyABI is the input ABI used to generate the binding from.
+const ERC1967ProxyABI = "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_logic\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"_data\",\"type\":\"bytes\"}],\"stateMutability\":\"payable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"implementation\",\"type\":\"address\"}],\"name\":\"Upgraded\",\"type\":\"event\"},{\"stateMutability\":\"payable\",\"type\":\"fallback\"},{\"stateMutability\":\"payable\",\"type\":\"receive\"}]"
+
+// ERC1967ProxyBin is the compiled bytecode used for deploying new contracts.
+var ERC1967ProxyBin = "0x60806040526040516104c83803806104c883398101604081905261002291610284565b61004d60017f360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbd610352565b6000805160206104818339815191521461006957610069610373565b6100728261008c565b80511561008557610083828261010c565b505b50506103d8565b803b6100fa5760405162461bcd60e51b815260206004820152603260248201527f4552433139363750726f78793a206e657720696d706c656d656e746174696f6e604482015271081a5cc81b9bdd08184818dbdb9d1c9858dd60721b60648201526084015b60405180910390fd5b60008051602061048183398151915255565b606061013183836040518060600160405280602781526020016104a16027913961013a565b90505b92915050565b6060833b6101995760405162461bcd60e51b815260206004820152602660248201527f416464726573733a2064656c65676174652063616c6c20746f206e6f6e2d636f6044820152651b9d1c9858dd60d21b60648201526084016100f1565b600080856001600160a01b0316856040516101b49190610389565b600060405180830381855af49150503d80600081146101ef576040519150601f19603f3d011682016040523d82523d6000602084013e6101f4565b606091505b509092509050610205828286610211565b925050505b9392505050565b6060831561022057508161020a565b8251156102305782518084602001fd5b8160405162461bcd60e51b81526004016100f191906103a5565b634e487b7160e01b600052604160045260246000fd5b60005b8381101561027b578181015183820152602001610263565b50506000910152565b6000806040838503121561029757600080fd5b82516001600160a01b03811681146102ae57600080fd5b60208401519092506001600160401b03808211156102cb57600080fd5b818501915085601f8301126102df57600080fd5b8151818111156102f1576102f161024a565b604051601f8201601f19908116603f011681019083821181831017156103195761031961024a565b8160405282815288602084870101111561033257600080fd5b610343836020830160208801610260565b80955050505050509250929050565b8181038181111561013457634e487b7160e01b600052601160045260246000fd5b634e487b7160e01b600052600160045260246000fd5b6000825161039b818460208701610260565b9190910192915050565b60208152600082518060208401526103c4816040850160208701610260565b601f01601f19169190910160400192915050565b609b806103e66000396000f3fe608060405236601057600e6013565b005b600e5b6040603c7f360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc5490565b6042565b565b3660008037600080366000845af43d6000803e8080156060573d6000f35b3d6000fdfea2646970667358221220ac79e4de73c2cf13e3a306c71bffbfab9eb9deee92abca327421fb1320dcfe2a64736f6c63430008150033360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc416464726573733a206c6f772d6c6576656c2064656c65676174652063616c6c206661696c6564"
+
+// DeployERC1967Proxy deploys a new Ethereum contract, binding an instance of ERC1967Proxy to it.
+func DeployERC1967Proxy(auth *bind.TransactOpts, backend bind.ContractBackend, _logic common.Address, _data []byte) (common.Address, *types.Transaction, *ERC1967Proxy, error) {
+	parsed, err := abi.JSON(strings.NewReader(ERC1967ProxyABI))
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, parsed, common.FromHex(ERC1967ProxyBin), backend, _logic, _data)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &ERC1967Proxy{ERC1967ProxyCaller: ERC1967ProxyCaller{contract: contract}, ERC1967ProxyTransactor: ERC1967ProxyTransactor{contract: contract}, ERC1967ProxyFilterer: ERC1967ProxyFilterer{contract: contract}}, nil
+}
+
+// ERC1967Proxy is an auto generated Go binding around an Ethereum contract.
+type ERC1967Proxy struct {
+	ERC1967ProxyCaller     // Read-only binding to the contract
+	ERC1967ProxyTransactor // Write-only binding to the contract
+	ERC1967ProxyFilterer   // Log filterer for contract events
+}
+
+// ERC1967ProxyCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ERC1967ProxyCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ERC1967ProxyTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ERC1967ProxyTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ERC1967ProxyFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ERC1967ProxyFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ERC1967ProxySession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ERC1967ProxySession struct {
+	Contract     *ERC1967Proxy     // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// ERC1967ProxyCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ERC1967ProxyCallerSession struct {
+	Contract *ERC1967ProxyCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts       // Call options to use throughout this session
+}
+
+// ERC1967ProxyTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ERC1967ProxyTransactorSession struct {
+	Contract     *ERC1967ProxyTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts       // Transaction auth options to use throughout this session
+}
+
+// ERC1967ProxyRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ERC1967ProxyRaw struct {
+	Contract *ERC1967Proxy // Generic contract binding to access the raw methods on
+}
+
+// ERC1967ProxyCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ERC1967ProxyCallerRaw struct {
+	Contract *ERC1967ProxyCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ERC1967ProxyTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ERC1967ProxyTransactorRaw struct {
+	Contract *ERC1967ProxyTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewERC1967Proxy creates a new instance of ERC1967Proxy, bound to a specific deployed contract.
+func NewERC1967Proxy(address common.Address, backend bind.ContractBackend) (*ERC1967Proxy, error) {
+	contract, err := bindERC1967Proxy(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC1967Proxy{ERC1967ProxyCaller: ERC1967ProxyCaller{contract: contract}, ERC1967ProxyTransactor: ERC1967ProxyTransactor{contract: contract}, ERC1967ProxyFilterer: ERC1967ProxyFilterer{contract: contract}}, nil
+}
+
+// NewERC1967ProxyCaller creates a new read-only instance of ERC1967Proxy, bound to a specific deployed contract.
+func NewERC1967ProxyCaller(address common.Address, caller bind.ContractCaller) (*ERC1967ProxyCaller, error) {
+	contract, err := bindERC1967Proxy(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC1967ProxyCaller{contract: contract}, nil
+}
+
+// NewERC1967ProxyTransactor creates a new write-only instance of ERC1967Proxy, bound to a specific deployed contract.
+func NewERC1967ProxyTransactor(address common.Address, transactor bind.ContractTransactor) (*ERC1967ProxyTransactor, error) {
+	contract, err := bindERC1967Proxy(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC1967ProxyTransactor{contract: contract}, nil
+}
+
+// NewERC1967ProxyFilterer creates a new log filterer instance of ERC1967Proxy, bound to a specific deployed contract.
+func NewERC1967ProxyFilterer(address common.Address, filterer bind.ContractFilterer) (*ERC1967ProxyFilterer, error) {
+	contract, err := bindERC1967Proxy(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC1967ProxyFilterer{contract: contract}, nil
+}
+
+// bindERC1967Proxy binds a generic wrapper to an already deployed contract.
+func bindERC1967Proxy(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(ERC1967ProxyABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ERC1967Proxy *ERC1967ProxyRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ERC1967Proxy.Contract.ERC1967ProxyCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ERC1967Proxy *ERC1967ProxyRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ERC1967Proxy.Contract.ERC1967ProxyTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ERC1967Proxy *ERC1967ProxyRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ERC1967Proxy.Contract.ERC1967ProxyTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ERC1967Proxy *ERC1967ProxyCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ERC1967Proxy.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ERC1967Proxy *ERC1967ProxyTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ERC1967Proxy.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ERC1967Proxy *ERC1967ProxyTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ERC1967Proxy.Contract.contract.Transact(opts, method, params...)
+}
+
+// Fallback is a paid mutator transaction binding the contract fallback function.
+//
+// Solidity: fallback() payable returns()
+func (_ERC1967Proxy *ERC1967ProxyTransactor) Fallback(opts *bind.TransactOpts, calldata []byte) (*types.Transaction, error) {
+	return _ERC1967Proxy.contract.RawTransact(opts, calldata)
+}
+
+// Fallback is a paid mutator transaction binding the contract fallback function.
+//
+// Solidity: fallback() payable returns()
+func (_ERC1967Proxy *ERC1967ProxySession) Fallback(calldata []byte) (*types.Transaction, error) {
+	return _ERC1967Proxy.Contract.Fallback(&_ERC1967Proxy.TransactOpts, calldata)
+}
+
+// Fallback is a paid mutator transaction binding the contract fallback function.
+//
+// Solidity: fallback() payable returns()
+func (_ERC1967Proxy *ERC1967ProxyTransactorSession) Fallback(calldata []byte) (*types.Transaction, error) {
+	return _ERC1967Proxy.Contract.Fallback(&_ERC1967Proxy.TransactOpts, calldata)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_ERC1967Proxy *ERC1967ProxyTransactor) Receive(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ERC1967Proxy.contract.RawTransact(opts, nil) // calldata is disallowed for receive function
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_ERC1967Proxy *ERC1967ProxySession) Receive() (*types.Transaction, error) {
+	return _ERC1967Proxy.Contract.Receive(&_ERC1967Proxy.TransactOpts)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_ERC1967Proxy *ERC1967ProxyTransactorSession) Receive() (*types.Transaction, error) {
+	return _ERC1967Proxy.Contract.Receive(&_ERC1967Proxy.TransactOpts)
+}
+
+// ERC1967ProxyUpgradedIterator is returned from FilterUpgraded and is used to iterate over the raw logs and unpacked data for Upgraded events raised by the ERC1967Proxy contract.
+type ERC1967ProxyUpgradedIterator struct {
+	Event *ERC1967ProxyUpgraded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC1967ProxyUpgradedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC1967ProxyUpgraded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC1967ProxyUpgraded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC1967ProxyUpgradedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC1967ProxyUpgradedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC1967ProxyUpgraded represents a Upgraded event raised by the ERC1967Proxy contract.
+type ERC1967ProxyUpgraded struct {
+	Implementation common.Address
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterUpgraded is a free log retrieval operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_ERC1967Proxy *ERC1967ProxyFilterer) FilterUpgraded(opts *bind.FilterOpts, implementation []common.Address) (*ERC1967ProxyUpgradedIterator, error) {
+
+	var implementationRule []interface{}
+	for _, implementationItem := range implementation {
+		implementationRule = append(implementationRule, implementationItem)
+	}
+
+	logs, sub, err := _ERC1967Proxy.contract.FilterLogs(opts, "Upgraded", implementationRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC1967ProxyUpgradedIterator{contract: _ERC1967Proxy.contract, event: "Upgraded", logs: logs, sub: sub}, nil
+}
+
+// WatchUpgraded is a free log subscription operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_ERC1967Proxy *ERC1967ProxyFilterer) WatchUpgraded(opts *bind.WatchOpts, sink chan<- *ERC1967ProxyUpgraded, implementation []common.Address) (event.Subscription, error) {
+
+	var implementationRule []interface{}
+	for _, implementationItem := range implementation {
+		implementationRule = append(implementationRule, implementationItem)
+	}
+
+	logs, sub, err := _ERC1967Proxy.contract.WatchLogs(opts, "Upgraded", implementationRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC1967ProxyUpgraded)
+				if err := _ERC1967Proxy.contract.UnpackLog(event, "Upgraded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUpgraded is a log parse operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_ERC1967Proxy *ERC1967ProxyFilterer) ParseUpgraded(log types.Log) (*ERC1967ProxyUpgraded, error) {
+	event := new(ERC1967ProxyUpgraded)
+	if err := _ERC1967Proxy.contract.UnpackLog(event, "Upgraded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// OwnableWithExpiryABI is the input ABI used to generate the binding from.
+const OwnableWithExpiryABI = "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"getOwnershipExpiryTimestamp\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"isOwnershipExpired\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnershipAfterExpiry\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
+
+// OwnableWithExpiryFuncSigs maps the 4-byte function signature to its string representation.
+var OwnableWithExpiryFuncSigs = map[string]string{
+	"1ee7a108": "getOwnershipExpiryTimestamp()",
+	"5afe97bb": "isOwnershipExpired()",
+	"8da5cb5b": "owner()",
+	"715018a6": "renounceOwnership()",
+	"8c64865f": "renounceOwnershipAfterExpiry()",
+	"f2fde38b": "transferOwnership(address)",
+}
+
+// OwnableWithExpiry is an auto generated Go binding around an Ethereum contract.
+type OwnableWithExpiry struct {
+	OwnableWithExpiryCaller     // Read-only binding to the contract
+	OwnableWithExpiryTransactor // Write-only binding to the contract
+	OwnableWithExpiryFilterer   // Log filterer for contract events
+}
+
+// OwnableWithExpiryCaller is an auto generated read-only Go binding around an Ethereum contract.
+type OwnableWithExpiryCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// OwnableWithExpiryTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type OwnableWithExpiryTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// OwnableWithExpiryFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type OwnableWithExpiryFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// OwnableWithExpirySession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type OwnableWithExpirySession struct {
+	Contract     *OwnableWithExpiry // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts      // Call options to use throughout this session
+	TransactOpts bind.TransactOpts  // Transaction auth options to use throughout this session
+}
+
+// OwnableWithExpiryCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type OwnableWithExpiryCallerSession struct {
+	Contract *OwnableWithExpiryCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts            // Call options to use throughout this session
+}
+
+// OwnableWithExpiryTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type OwnableWithExpiryTransactorSession struct {
+	Contract     *OwnableWithExpiryTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts            // Transaction auth options to use throughout this session
+}
+
+// OwnableWithExpiryRaw is an auto generated low-level Go binding around an Ethereum contract.
+type OwnableWithExpiryRaw struct {
+	Contract *OwnableWithExpiry // Generic contract binding to access the raw methods on
+}
+
+// OwnableWithExpiryCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type OwnableWithExpiryCallerRaw struct {
+	Contract *OwnableWithExpiryCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// OwnableWithExpiryTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type OwnableWithExpiryTransactorRaw struct {
+	Contract *OwnableWithExpiryTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewOwnableWithExpiry creates a new instance of OwnableWithExpiry, bound to a specific deployed contract.
+func NewOwnableWithExpiry(address common.Address, backend bind.ContractBackend) (*OwnableWithExpiry, error) {
+	contract, err := bindOwnableWithExpiry(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &OwnableWithExpiry{OwnableWithExpiryCaller: OwnableWithExpiryCaller{contract: contract}, OwnableWithExpiryTransactor: OwnableWithExpiryTransactor{contract: contract}, OwnableWithExpiryFilterer: OwnableWithExpiryFilterer{contract: contract}}, nil
+}
+
+// NewOwnableWithExpiryCaller creates a new read-only instance of OwnableWithExpiry, bound to a specific deployed contract.
+func NewOwnableWithExpiryCaller(address common.Address, caller bind.ContractCaller) (*OwnableWithExpiryCaller, error) {
+	contract, err := bindOwnableWithExpiry(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &OwnableWithExpiryCaller{contract: contract}, nil
+}
+
+// NewOwnableWithExpiryTransactor creates a new write-only instance of OwnableWithExpiry, bound to a specific deployed contract.
+func NewOwnableWithExpiryTransactor(address common.Address, transactor bind.ContractTransactor) (*OwnableWithExpiryTransactor, error) {
+	contract, err := bindOwnableWithExpiry(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &OwnableWithExpiryTransactor{contract: contract}, nil
+}
+
+// NewOwnableWithExpiryFilterer creates a new log filterer instance of OwnableWithExpiry, bound to a specific deployed contract.
+func NewOwnableWithExpiryFilterer(address common.Address, filterer bind.ContractFilterer) (*OwnableWithExpiryFilterer, error) {
+	contract, err := bindOwnableWithExpiry(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &OwnableWithExpiryFilterer{contract: contract}, nil
+}
+
+// bindOwnableWithExpiry binds a generic wrapper to an already deployed contract.
+func bindOwnableWithExpiry(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(OwnableWithExpiryABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_OwnableWithExpiry *OwnableWithExpiryRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _OwnableWithExpiry.Contract.OwnableWithExpiryCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_OwnableWithExpiry *OwnableWithExpiryRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _OwnableWithExpiry.Contract.OwnableWithExpiryTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_OwnableWithExpiry *OwnableWithExpiryRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _OwnableWithExpiry.Contract.OwnableWithExpiryTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_OwnableWithExpiry *OwnableWithExpiryCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _OwnableWithExpiry.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_OwnableWithExpiry *OwnableWithExpiryTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _OwnableWithExpiry.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_OwnableWithExpiry *OwnableWithExpiryTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _OwnableWithExpiry.Contract.contract.Transact(opts, method, params...)
+}
+
+// GetOwnershipExpiryTimestamp is a free data retrieval call binding the contract method 0x1ee7a108.
+//
+// Solidity: function getOwnershipExpiryTimestamp() view returns(uint256)
+func (_OwnableWithExpiry *OwnableWithExpiryCaller) GetOwnershipExpiryTimestamp(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _OwnableWithExpiry.contract.Call(opts, &out, "getOwnershipExpiryTimestamp")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOwnershipExpiryTimestamp is a free data retrieval call binding the contract method 0x1ee7a108.
+//
+// Solidity: function getOwnershipExpiryTimestamp() view returns(uint256)
+func (_OwnableWithExpiry *OwnableWithExpirySession) GetOwnershipExpiryTimestamp() (*big.Int, error) {
+	return _OwnableWithExpiry.Contract.GetOwnershipExpiryTimestamp(&_OwnableWithExpiry.CallOpts)
+}
+
+// GetOwnershipExpiryTimestamp is a free data retrieval call binding the contract method 0x1ee7a108.
+//
+// Solidity: function getOwnershipExpiryTimestamp() view returns(uint256)
+func (_OwnableWithExpiry *OwnableWithExpiryCallerSession) GetOwnershipExpiryTimestamp() (*big.Int, error) {
+	return _OwnableWithExpiry.Contract.GetOwnershipExpiryTimestamp(&_OwnableWithExpiry.CallOpts)
+}
+
+// IsOwnershipExpired is a free data retrieval call binding the contract method 0x5afe97bb.
+//
+// Solidity: function isOwnershipExpired() view returns(bool)
+func (_OwnableWithExpiry *OwnableWithExpiryCaller) IsOwnershipExpired(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _OwnableWithExpiry.contract.Call(opts, &out, "isOwnershipExpired")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsOwnershipExpired is a free data retrieval call binding the contract method 0x5afe97bb.
+//
+// Solidity: function isOwnershipExpired() view returns(bool)
+func (_OwnableWithExpiry *OwnableWithExpirySession) IsOwnershipExpired() (bool, error) {
+	return _OwnableWithExpiry.Contract.IsOwnershipExpired(&_OwnableWithExpiry.CallOpts)
+}
+
+// IsOwnershipExpired is a free data retrieval call binding the contract method 0x5afe97bb.
+//
+// Solidity: function isOwnershipExpired() view returns(bool)
+func (_OwnableWithExpiry *OwnableWithExpiryCallerSession) IsOwnershipExpired() (bool, error) {
+	return _OwnableWithExpiry.Contract.IsOwnershipExpired(&_OwnableWithExpiry.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_OwnableWithExpiry *OwnableWithExpiryCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _OwnableWithExpiry.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_OwnableWithExpiry *OwnableWithExpirySession) Owner() (common.Address, error) {
+	return _OwnableWithExpiry.Contract.Owner(&_OwnableWithExpiry.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_OwnableWithExpiry *OwnableWithExpiryCallerSession) Owner() (common.Address, error) {
+	return _OwnableWithExpiry.Contract.Owner(&_OwnableWithExpiry.CallOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_OwnableWithExpiry *OwnableWithExpiryTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _OwnableWithExpiry.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_OwnableWithExpiry *OwnableWithExpirySession) RenounceOwnership() (*types.Transaction, error) {
+	return _OwnableWithExpiry.Contract.RenounceOwnership(&_OwnableWithExpiry.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_OwnableWithExpiry *OwnableWithExpiryTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _OwnableWithExpiry.Contract.RenounceOwnership(&_OwnableWithExpiry.TransactOpts)
+}
+
+// RenounceOwnershipAfterExpiry is a paid mutator transaction binding the contract method 0x8c64865f.
+//
+// Solidity: function renounceOwnershipAfterExpiry() returns()
+func (_OwnableWithExpiry *OwnableWithExpiryTransactor) RenounceOwnershipAfterExpiry(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _OwnableWithExpiry.contract.Transact(opts, "renounceOwnershipAfterExpiry")
+}
+
+// RenounceOwnershipAfterExpiry is a paid mutator transaction binding the contract method 0x8c64865f.
+//
+// Solidity: function renounceOwnershipAfterExpiry() returns()
+func (_OwnableWithExpiry *OwnableWithExpirySession) RenounceOwnershipAfterExpiry() (*types.Transaction, error) {
+	return _OwnableWithExpiry.Contract.RenounceOwnershipAfterExpiry(&_OwnableWithExpiry.TransactOpts)
+}
+
+// RenounceOwnershipAfterExpiry is a paid mutator transaction binding the contract method 0x8c64865f.
+//
+// Solidity: function renounceOwnershipAfterExpiry() returns()
+func (_OwnableWithExpiry *OwnableWithExpiryTransactorSession) RenounceOwnershipAfterExpiry() (*types.Transaction, error) {
+	return _OwnableWithExpiry.Contract.RenounceOwnershipAfterExpiry(&_OwnableWithExpiry.TransactOpts)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_OwnableWithExpiry *OwnableWithExpiryTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _OwnableWithExpiry.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_OwnableWithExpiry *OwnableWithExpirySession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _OwnableWithExpiry.Contract.TransferOwnership(&_OwnableWithExpiry.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_OwnableWithExpiry *OwnableWithExpiryTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _OwnableWithExpiry.Contract.TransferOwnership(&_OwnableWithExpiry.TransactOpts, newOwner)
+}
+
+// OwnableWithExpiryOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the OwnableWithExpiry contract.
+type OwnableWithExpiryOwnershipTransferredIterator struct {
+	Event *OwnableWithExpiryOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OwnableWithExpiryOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(OwnableWithExpiryOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(OwnableWithExpiryOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OwnableWithExpiryOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OwnableWithExpiryOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// OwnableWithExpiryOwnershipTransferred represents a OwnershipTransferred event raised by the OwnableWithExpiry contract.
+type OwnableWithExpiryOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_OwnableWithExpiry *OwnableWithExpiryFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*OwnableWithExpiryOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _OwnableWithExpiry.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &OwnableWithExpiryOwnershipTransferredIterator{contract: _OwnableWithExpiry.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_OwnableWithExpiry *OwnableWithExpiryFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *OwnableWithExpiryOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _OwnableWithExpiry.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(OwnableWithExpiryOwnershipTransferred)
+				if err := _OwnableWithExpiry.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_OwnableWithExpiry *OwnableWithExpiryFilterer) ParseOwnershipTransferred(log types.Log) (*OwnableWithExpiryOwnershipTransferred, error) {
+	event := new(OwnableWithExpiryOwnershipTransferred)
+	if err := _OwnableWithExpiry.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ProxyABI is the input ABI used to generate the binding from.
+const ProxyABI = "[{\"stateMutability\":\"payable\",\"type\":\"fallback\"},{\"stateMutability\":\"payable\",\"type\":\"receive\"}]"
+
+// Proxy is an auto generated Go binding around an Ethereum contract.
+type Proxy struct {
+	ProxyCaller     // Read-only binding to the contract
+	ProxyTransactor // Write-only binding to the contract
+	ProxyFilterer   // Log filterer for contract events
+}
+
+// ProxyCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ProxyCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ProxyTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ProxyTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ProxyFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ProxyFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ProxySession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ProxySession struct {
+	Contract     *Proxy            // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// ProxyCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ProxyCallerSession struct {
+	Contract *ProxyCaller  // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts // Call options to use throughout this session
+}
+
+// ProxyTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ProxyTransactorSession struct {
+	Contract     *ProxyTransactor  // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// ProxyRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ProxyRaw struct {
+	Contract *Proxy // Generic contract binding to access the raw methods on
+}
+
+// ProxyCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ProxyCallerRaw struct {
+	Contract *ProxyCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ProxyTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ProxyTransactorRaw struct {
+	Contract *ProxyTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewProxy creates a new instance of Proxy, bound to a specific deployed contract.
+func NewProxy(address common.Address, backend bind.ContractBackend) (*Proxy, error) {
+	contract, err := bindProxy(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Proxy{ProxyCaller: ProxyCaller{contract: contract}, ProxyTransactor: ProxyTransactor{contract: contract}, ProxyFilterer: ProxyFilterer{contract: contract}}, nil
+}
+
+// NewProxyCaller creates a new read-only instance of Proxy, bound to a specific deployed contract.
+func NewProxyCaller(address common.Address, caller bind.ContractCaller) (*ProxyCaller, error) {
+	contract, err := bindProxy(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ProxyCaller{contract: contract}, nil
+}
+
+// NewProxyTransactor creates a new write-only instance of Proxy, bound to a specific deployed contract.
+func NewProxyTransactor(address common.Address, transactor bind.ContractTransactor) (*ProxyTransactor, error) {
+	contract, err := bindProxy(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ProxyTransactor{contract: contract}, nil
+}
+
+// NewProxyFilterer creates a new log filterer instance of Proxy, bound to a specific deployed contract.
+func NewProxyFilterer(address common.Address, filterer bind.ContractFilterer) (*ProxyFilterer, error) {
+	contract, err := bindProxy(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ProxyFilterer{contract: contract}, nil
+}
+
+// bindProxy binds a generic wrapper to an already deployed contract.
+func bindProxy(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(ProxyABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Proxy *ProxyRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Proxy.Contract.ProxyCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Proxy *ProxyRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Proxy.Contract.ProxyTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Proxy *ProxyRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Proxy.Contract.ProxyTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Proxy *ProxyCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Proxy.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Proxy *ProxyTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Proxy.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Proxy *ProxyTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Proxy.Contract.contract.Transact(opts, method, params...)
+}
+
+// Fallback is a paid mutator transaction binding the contract fallback function.
+//
+// Solidity: fallback() payable returns()
+func (_Proxy *ProxyTransactor) Fallback(opts *bind.TransactOpts, calldata []byte) (*types.Transaction, error) {
+	return _Proxy.contract.RawTransact(opts, calldata)
+}
+
+// Fallback is a paid mutator transaction binding the contract fallback function.
+//
+// Solidity: fallback() payable returns()
+func (_Proxy *ProxySession) Fallback(calldata []byte) (*types.Transaction, error) {
+	return _Proxy.Contract.Fallback(&_Proxy.TransactOpts, calldata)
+}
+
+// Fallback is a paid mutator transaction binding the contract fallback function.
+//
+// Solidity: fallback() payable returns()
+func (_Proxy *ProxyTransactorSession) Fallback(calldata []byte) (*types.Transaction, error) {
+	return _Proxy.Contract.Fallback(&_Proxy.TransactOpts, calldata)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_Proxy *ProxyTransactor) Receive(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Proxy.contract.RawTransact(opts, nil) // calldata is disallowed for receive function
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_Proxy *ProxySession) Receive() (*types.Transaction, error) {
+	return _Proxy.Contract.Receive(&_Proxy.TransactOpts)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_Proxy *ProxyTransactorSession) Receive() (*types.Transaction, error) {
+	return _Proxy.Contract.Receive(&_Proxy.TransactOpts)
+}
+
+// ProxyAdminABI is the input ABI used to generate the binding from.
+const ProxyAdminABI = "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"contractTransparentUpgradeableProxy\",\"name\":\"proxy\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"newAdmin\",\"type\":\"address\"}],\"name\":\"changeProxyAdmin\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getOwnershipExpiryTimestamp\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractTransparentUpgradeableProxy\",\"name\":\"proxy\",\"type\":\"address\"}],\"name\":\"getProxyAdmin\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractTransparentUpgradeableProxy\",\"name\":\"proxy\",\"type\":\"address\"}],\"name\":\"getProxyImplementation\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"isOwnershipExpired\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnershipAfterExpiry\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractTransparentUpgradeableProxy\",\"name\":\"proxy\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"implementation\",\"type\":\"address\"}],\"name\":\"upgrade\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractTransparentUpgradeableProxy\",\"name\":\"proxy\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"implementation\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"upgradeAndCall\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"}]"
+
+// ProxyAdminFuncSigs maps the 4-byte function signature to its string representation.
+var ProxyAdminFuncSigs = map[string]string{
+	"7eff275e": "changeProxyAdmin(address,address)",
+	"1ee7a108": "getOwnershipExpiryTimestamp()",
+	"f3b7dead": "getProxyAdmin(address)",
+	"204e1c7a": "getProxyImplementation(address)",
+	"5afe97bb": "isOwnershipExpired()",
+	"8da5cb5b": "owner()",
+	"715018a6": "renounceOwnership()",
+	"8c64865f": "renounceOwnershipAfterExpiry()",
+	"f2fde38b": "transferOwnership(address)",
+	"99a88ec4": "upgrade(address,address)",
+	"9623609d": "upgradeAndCall(address,address,bytes)",
+}
+
+// ProxyAdminBin is the compiled bytecode used for deploying new contracts.
+var ProxyAdminBin = "0x608060405234801561001057600080fd5b50600080546001600160a01b03191633908117825542600155604051909182917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0908290a35061088a806100656000396000f3fe60806040526004361061009c5760003560e01c80638c64865f116100645780638c64865f1461015d5780638da5cb5b146101725780639623609d1461019057806399a88ec4146101a3578063f2fde38b146101c3578063f3b7dead146101e357600080fd5b80631ee7a108146100a1578063204e1c7a146100c95780635afe97bb14610101578063715018a6146101265780637eff275e1461013d575b600080fd5b3480156100ad57600080fd5b506100b6610203565b6040519081526020015b60405180910390f35b3480156100d557600080fd5b506100e96100e4366004610634565b61021c565b6040516001600160a01b0390911681526020016100c0565b34801561010d57600080fd5b506101166102ad565b60405190151581526020016100c0565b34801561013257600080fd5b5061013b6102be565b005b34801561014957600080fd5b5061013b610158366004610658565b6102fb565b34801561016957600080fd5b5061013b610385565b34801561017e57600080fd5b506000546001600160a01b03166100e9565b61013b61019e3660046106a7565b6103d9565b3480156101af57600080fd5b5061013b6101be366004610658565b61046a565b3480156101cf57600080fd5b5061013b6101de366004610634565b6104c2565b3480156101ef57600080fd5b506100e96101fe366004610634565b6105ac565b6000600154630114db00610217919061077d565b905090565b6000806000836001600160a01b031660405161024290635c60da1b60e01b815260040190565b600060405180830381855afa9150503d806000811461027d576040519150601f19603f3d011682016040523d82523d6000602084013e610282565b606091505b50915091508161029157600080fd5b808060200190518101906102a591906107a4565b949350505050565b60006102b7610203565b4211905090565b6000546001600160a01b031633146102f15760405162461bcd60e51b81526004016102e8906107c1565b60405180910390fd5b6102f96105d2565b565b6000546001600160a01b031633146103255760405162461bcd60e51b81526004016102e8906107c1565b6040516308f2839760e41b81526001600160a01b038281166004830152831690638f283970906024015b600060405180830381600087803b15801561036957600080fd5b505af115801561037d573d6000803e3d6000fd5b505050505050565b61038d6102ad565b6102f15760405162461bcd60e51b815260206004820152601960248201527f4f776e657273686970206e6f742079657420657870697265640000000000000060448201526064016102e8565b6000546001600160a01b031633146104035760405162461bcd60e51b81526004016102e8906107c1565b60405163278f794360e11b81526001600160a01b03841690634f1ef28690349061043390869086906004016107f6565b6000604051808303818588803b15801561044c57600080fd5b505af1158015610460573d6000803e3d6000fd5b5050505050505050565b6000546001600160a01b031633146104945760405162461bcd60e51b81526004016102e8906107c1565b604051631b2ce7f360e11b81526001600160a01b038281166004830152831690633659cfe69060240161034f565b6000546001600160a01b031633146104ec5760405162461bcd60e51b81526004016102e8906107c1565b6001600160a01b0381166105515760405162461bcd60e51b815260206004820152602660248201527f4f776e61626c653a206e6577206f776e657220697320746865207a65726f206160448201526564647265737360d01b60648201526084016102e8565b600080546040516001600160a01b03808516939216917f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e091a3600080546001600160a01b0319166001600160a01b0392909216919091179055565b6000806000836001600160a01b0316604051610242906303e1469160e61b815260040190565b600080546040516001600160a01b03909116907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0908390a3600080546001600160a01b0319169055565b6001600160a01b038116811461063157600080fd5b50565b60006020828403121561064657600080fd5b81356106518161061c565b9392505050565b6000806040838503121561066b57600080fd5b82356106768161061c565b915060208301356106868161061c565b809150509250929050565b634e487b7160e01b600052604160045260246000fd5b6000806000606084860312156106bc57600080fd5b83356106c78161061c565b925060208401356106d78161061c565b9150604084013567ffffffffffffffff808211156106f457600080fd5b818601915086601f83011261070857600080fd5b81358181111561071a5761071a610691565b604051601f8201601f19908116603f0116810190838211818310171561074257610742610691565b8160405282815289602084870101111561075b57600080fd5b8260208601602083013760006020848301015280955050505050509250925092565b8082018082111561079e57634e487b7160e01b600052601160045260246000fd5b92915050565b6000602082840312156107b657600080fd5b81516106518161061c565b6020808252818101527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e6572604082015260600190565b60018060a01b038316815260006020604081840152835180604085015260005b8181101561083257858101830151858201606001528201610816565b506000606082860101526060601f19601f83011685010192505050939250505056fea2646970667358221220e6650818ce77c59a250ca38bd5c3945082b8f06a020029d15a45a1735fb20d9164736f6c63430008150033"
+
+// DeployProxyAdmin deploys a new Ethereum contract, binding an instance of ProxyAdmin to it.
+func DeployProxyAdmin(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *ProxyAdmin, error) {
+	parsed, err := abi.JSON(strings.NewReader(ProxyAdminABI))
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, parsed, common.FromHex(ProxyAdminBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &ProxyAdmin{ProxyAdminCaller: ProxyAdminCaller{contract: contract}, ProxyAdminTransactor: ProxyAdminTransactor{contract: contract}, ProxyAdminFilterer: ProxyAdminFilterer{contract: contract}}, nil
+}
+
+// ProxyAdmin is an auto generated Go binding around an Ethereum contract.
+type ProxyAdmin struct {
+	ProxyAdminCaller     // Read-only binding to the contract
+	ProxyAdminTransactor // Write-only binding to the contract
+	ProxyAdminFilterer   // Log filterer for contract events
+}
+
+// ProxyAdminCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ProxyAdminCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ProxyAdminTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ProxyAdminTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ProxyAdminFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ProxyAdminFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ProxyAdminSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ProxyAdminSession struct {
+	Contract     *ProxyAdmin       // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// ProxyAdminCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ProxyAdminCallerSession struct {
+	Contract *ProxyAdminCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts     // Call options to use throughout this session
+}
+
+// ProxyAdminTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ProxyAdminTransactorSession struct {
+	Contract     *ProxyAdminTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts     // Transaction auth options to use throughout this session
+}
+
+// ProxyAdminRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ProxyAdminRaw struct {
+	Contract *ProxyAdmin // Generic contract binding to access the raw methods on
+}
+
+// ProxyAdminCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ProxyAdminCallerRaw struct {
+	Contract *ProxyAdminCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ProxyAdminTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ProxyAdminTransactorRaw struct {
+	Contract *ProxyAdminTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewProxyAdmin creates a new instance of ProxyAdmin, bound to a specific deployed contract.
+func NewProxyAdmin(address common.Address, backend bind.ContractBackend) (*ProxyAdmin, error) {
+	contract, err := bindProxyAdmin(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &ProxyAdmin{ProxyAdminCaller: ProxyAdminCaller{contract: contract}, ProxyAdminTransactor: ProxyAdminTransactor{contract: contract}, ProxyAdminFilterer: ProxyAdminFilterer{contract: contract}}, nil
+}
+
+// NewProxyAdminCaller creates a new read-only instance of ProxyAdmin, bound to a specific deployed contract.
+func NewProxyAdminCaller(address common.Address, caller bind.ContractCaller) (*ProxyAdminCaller, error) {
+	contract, err := bindProxyAdmin(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ProxyAdminCaller{contract: contract}, nil
+}
+
+// NewProxyAdminTransactor creates a new write-only instance of ProxyAdmin, bound to a specific deployed contract.
+func NewProxyAdminTransactor(address common.Address, transactor bind.ContractTransactor) (*ProxyAdminTransactor, error) {
+	contract, err := bindProxyAdmin(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ProxyAdminTransactor{contract: contract}, nil
+}
+
+// NewProxyAdminFilterer creates a new log filterer instance of ProxyAdmin, bound to a specific deployed contract.
+func NewProxyAdminFilterer(address common.Address, filterer bind.ContractFilterer) (*ProxyAdminFilterer, error) {
+	contract, err := bindProxyAdmin(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ProxyAdminFilterer{contract: contract}, nil
+}
+
+// bindProxyAdmin binds a generic wrapper to an already deployed contract.
+func bindProxyAdmin(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(ProxyAdminABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ProxyAdmin *ProxyAdminRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ProxyAdmin.Contract.ProxyAdminCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ProxyAdmin *ProxyAdminRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ProxyAdmin.Contract.ProxyAdminTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ProxyAdmin *ProxyAdminRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ProxyAdmin.Contract.ProxyAdminTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ProxyAdmin *ProxyAdminCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ProxyAdmin.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ProxyAdmin *ProxyAdminTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ProxyAdmin.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ProxyAdmin *ProxyAdminTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ProxyAdmin.Contract.contract.Transact(opts, method, params...)
+}
+
+// GetOwnershipExpiryTimestamp is a free data retrieval call binding the contract method 0x1ee7a108.
+//
+// Solidity: function getOwnershipExpiryTimestamp() view returns(uint256)
+func (_ProxyAdmin *ProxyAdminCaller) GetOwnershipExpiryTimestamp(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ProxyAdmin.contract.Call(opts, &out, "getOwnershipExpiryTimestamp")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOwnershipExpiryTimestamp is a free data retrieval call binding the contract method 0x1ee7a108.
+//
+// Solidity: function getOwnershipExpiryTimestamp() view returns(uint256)
+func (_ProxyAdmin *ProxyAdminSession) GetOwnershipExpiryTimestamp() (*big.Int, error) {
+	return _ProxyAdmin.Contract.GetOwnershipExpiryTimestamp(&_ProxyAdmin.CallOpts)
+}
+
+// GetOwnershipExpiryTimestamp is a free data retrieval call binding the contract method 0x1ee7a108.
+//
+// Solidity: function getOwnershipExpiryTimestamp() view returns(uint256)
+func (_ProxyAdmin *ProxyAdminCallerSession) GetOwnershipExpiryTimestamp() (*big.Int, error) {
+	return _ProxyAdmin.Contract.GetOwnershipExpiryTimestamp(&_ProxyAdmin.CallOpts)
+}
+
+// GetProxyAdmin is a free data retrieval call binding the contract method 0xf3b7dead.
+//
+// Solidity: function getProxyAdmin(address proxy) view returns(address)
+func (_ProxyAdmin *ProxyAdminCaller) GetProxyAdmin(opts *bind.CallOpts, proxy common.Address) (common.Address, error) {
+	var out []interface{}
+	err := _ProxyAdmin.contract.Call(opts, &out, "getProxyAdmin", proxy)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetProxyAdmin is a free data retrieval call binding the contract method 0xf3b7dead.
+//
+// Solidity: function getProxyAdmin(address proxy) view returns(address)
+func (_ProxyAdmin *ProxyAdminSession) GetProxyAdmin(proxy common.Address) (common.Address, error) {
+	return _ProxyAdmin.Contract.GetProxyAdmin(&_ProxyAdmin.CallOpts, proxy)
+}
+
+// GetProxyAdmin is a free data retrieval call binding the contract method 0xf3b7dead.
+//
+// Solidity: function getProxyAdmin(address proxy) view returns(address)
+func (_ProxyAdmin *ProxyAdminCallerSession) GetProxyAdmin(proxy common.Address) (common.Address, error) {
+	return _ProxyAdmin.Contract.GetProxyAdmin(&_ProxyAdmin.CallOpts, proxy)
+}
+
+// GetProxyImplementation is a free data retrieval call binding the contract method 0x204e1c7a.
+//
+// Solidity: function getProxyImplementation(address proxy) view returns(address)
+func (_ProxyAdmin *ProxyAdminCaller) GetProxyImplementation(opts *bind.CallOpts, proxy common.Address) (common.Address, error) {
+	var out []interface{}
+	err := _ProxyAdmin.contract.Call(opts, &out, "getProxyImplementation", proxy)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetProxyImplementation is a free data retrieval call binding the contract method 0x204e1c7a.
+//
+// Solidity: function getProxyImplementation(address proxy) view returns(address)
+func (_ProxyAdmin *ProxyAdminSession) GetProxyImplementation(proxy common.Address) (common.Address, error) {
+	return _ProxyAdmin.Contract.GetProxyImplementation(&_ProxyAdmin.CallOpts, proxy)
+}
+
+// GetProxyImplementation is a free data retrieval call binding the contract method 0x204e1c7a.
+//
+// Solidity: function getProxyImplementation(address proxy) view returns(address)
+func (_ProxyAdmin *ProxyAdminCallerSession) GetProxyImplementation(proxy common.Address) (common.Address, error) {
+	return _ProxyAdmin.Contract.GetProxyImplementation(&_ProxyAdmin.CallOpts, proxy)
+}
+
+// IsOwnershipExpired is a free data retrieval call binding the contract method 0x5afe97bb.
+//
+// Solidity: function isOwnershipExpired() view returns(bool)
+func (_ProxyAdmin *ProxyAdminCaller) IsOwnershipExpired(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _ProxyAdmin.contract.Call(opts, &out, "isOwnershipExpired")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsOwnershipExpired is a free data retrieval call binding the contract method 0x5afe97bb.
+//
+// Solidity: function isOwnershipExpired() view returns(bool)
+func (_ProxyAdmin *ProxyAdminSession) IsOwnershipExpired() (bool, error) {
+	return _ProxyAdmin.Contract.IsOwnershipExpired(&_ProxyAdmin.CallOpts)
+}
+
+// IsOwnershipExpired is a free data retrieval call binding the contract method 0x5afe97bb.
+//
+// Solidity: function isOwnershipExpired() view returns(bool)
+func (_ProxyAdmin *ProxyAdminCallerSession) IsOwnershipExpired() (bool, error) {
+	return _ProxyAdmin.Contract.IsOwnershipExpired(&_ProxyAdmin.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_ProxyAdmin *ProxyAdminCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ProxyAdmin.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_ProxyAdmin *ProxyAdminSession) Owner() (common.Address, error) {
+	return _ProxyAdmin.Contract.Owner(&_ProxyAdmin.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_ProxyAdmin *ProxyAdminCallerSession) Owner() (common.Address, error) {
+	return _ProxyAdmin.Contract.Owner(&_ProxyAdmin.CallOpts)
+}
+
+// ChangeProxyAdmin is a paid mutator transaction binding the contract method 0x7eff275e.
+//
+// Solidity: function changeProxyAdmin(address proxy, address newAdmin) returns()
+func (_ProxyAdmin *ProxyAdminTransactor) ChangeProxyAdmin(opts *bind.TransactOpts, proxy common.Address, newAdmin common.Address) (*types.Transaction, error) {
+	return _ProxyAdmin.contract.Transact(opts, "changeProxyAdmin", proxy, newAdmin)
+}
+
+// ChangeProxyAdmin is a paid mutator transaction binding the contract method 0x7eff275e.
+//
+// Solidity: function changeProxyAdmin(address proxy, address newAdmin) returns()
+func (_ProxyAdmin *ProxyAdminSession) ChangeProxyAdmin(proxy common.Address, newAdmin common.Address) (*types.Transaction, error) {
+	return _ProxyAdmin.Contract.ChangeProxyAdmin(&_ProxyAdmin.TransactOpts, proxy, newAdmin)
+}
+
+// ChangeProxyAdmin is a paid mutator transaction binding the contract method 0x7eff275e.
+//
+// Solidity: function changeProxyAdmin(address proxy, address newAdmin) returns()
+func (_ProxyAdmin *ProxyAdminTransactorSession) ChangeProxyAdmin(proxy common.Address, newAdmin common.Address) (*types.Transaction, error) {
+	return _ProxyAdmin.Contract.ChangeProxyAdmin(&_ProxyAdmin.TransactOpts, proxy, newAdmin)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_ProxyAdmin *ProxyAdminTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ProxyAdmin.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_ProxyAdmin *ProxyAdminSession) RenounceOwnership() (*types.Transaction, error) {
+	return _ProxyAdmin.Contract.RenounceOwnership(&_ProxyAdmin.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_ProxyAdmin *ProxyAdminTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _ProxyAdmin.Contract.RenounceOwnership(&_ProxyAdmin.TransactOpts)
+}
+
+// RenounceOwnershipAfterExpiry is a paid mutator transaction binding the contract method 0x8c64865f.
+//
+// Solidity: function renounceOwnershipAfterExpiry() returns()
+func (_ProxyAdmin *ProxyAdminTransactor) RenounceOwnershipAfterExpiry(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ProxyAdmin.contract.Transact(opts, "renounceOwnershipAfterExpiry")
+}
+
+// RenounceOwnershipAfterExpiry is a paid mutator transaction binding the contract method 0x8c64865f.
+//
+// Solidity: function renounceOwnershipAfterExpiry() returns()
+func (_ProxyAdmin *ProxyAdminSession) RenounceOwnershipAfterExpiry() (*types.Transaction, error) {
+	return _ProxyAdmin.Contract.RenounceOwnershipAfterExpiry(&_ProxyAdmin.TransactOpts)
+}
+
+// RenounceOwnershipAfterExpiry is a paid mutator transaction binding the contract method 0x8c64865f.
+//
+// Solidity: function renounceOwnershipAfterExpiry() returns()
+func (_ProxyAdmin *ProxyAdminTransactorSession) RenounceOwnershipAfterExpiry() (*types.Transaction, error) {
+	return _ProxyAdmin.Contract.RenounceOwnershipAfterExpiry(&_ProxyAdmin.TransactOpts)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_ProxyAdmin *ProxyAdminTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _ProxyAdmin.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_ProxyAdmin *ProxyAdminSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _ProxyAdmin.Contract.TransferOwnership(&_ProxyAdmin.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_ProxyAdmin *ProxyAdminTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _ProxyAdmin.Contract.TransferOwnership(&_ProxyAdmin.TransactOpts, newOwner)
+}
+
+// Upgrade is a paid mutator transaction binding the contract method 0x99a88ec4.
+//
+// Solidity: function upgrade(address proxy, address implementation) returns()
+func (_ProxyAdmin *ProxyAdminTransactor) Upgrade(opts *bind.TransactOpts, proxy common.Address, implementation common.Address) (*types.Transaction, error) {
+	return _ProxyAdmin.contract.Transact(opts, "upgrade", proxy, implementation)
+}
+
+// Upgrade is a paid mutator transaction binding the contract method 0x99a88ec4.
+//
+// Solidity: function upgrade(address proxy, address implementation) returns()
+func (_ProxyAdmin *ProxyAdminSession) Upgrade(proxy common.Address, implementation common.Address) (*types.Transaction, error) {
+	return _ProxyAdmin.Contract.Upgrade(&_ProxyAdmin.TransactOpts, proxy, implementation)
+}
+
+// Upgrade is a paid mutator transaction binding the contract method 0x99a88ec4.
+//
+// Solidity: function upgrade(address proxy, address implementation) returns()
+func (_ProxyAdmin *ProxyAdminTransactorSession) Upgrade(proxy common.Address, implementation common.Address) (*types.Transaction, error) {
+	return _ProxyAdmin.Contract.Upgrade(&_ProxyAdmin.TransactOpts, proxy, implementation)
+}
+
+// UpgradeAndCall is a paid mutator transaction binding the contract method 0x9623609d.
+//
+// Solidity: function upgradeAndCall(address proxy, address implementation, bytes data) payable returns()
+func (_ProxyAdmin *ProxyAdminTransactor) UpgradeAndCall(opts *bind.TransactOpts, proxy common.Address, implementation common.Address, data []byte) (*types.Transaction, error) {
+	return _ProxyAdmin.contract.Transact(opts, "upgradeAndCall", proxy, implementation, data)
+}
+
+// UpgradeAndCall is a paid mutator transaction binding the contract method 0x9623609d.
+//
+// Solidity: function upgradeAndCall(address proxy, address implementation, bytes data) payable returns()
+func (_ProxyAdmin *ProxyAdminSession) UpgradeAndCall(proxy common.Address, implementation common.Address, data []byte) (*types.Transaction, error) {
+	return _ProxyAdmin.Contract.UpgradeAndCall(&_ProxyAdmin.TransactOpts, proxy, implementation, data)
+}
+
+// UpgradeAndCall is a paid mutator transaction binding the contract method 0x9623609d.
+//
+// Solidity: function upgradeAndCall(address proxy, address implementation, bytes data) payable returns()
+func (_ProxyAdmin *ProxyAdminTransactorSession) UpgradeAndCall(proxy common.Address, implementation common.Address, data []byte) (*types.Transaction, error) {
+	return _ProxyAdmin.Contract.UpgradeAndCall(&_ProxyAdmin.TransactOpts, proxy, implementation, data)
+}
+
+// ProxyAdminOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the ProxyAdmin contract.
+type ProxyAdminOwnershipTransferredIterator struct {
+	Event *ProxyAdminOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ProxyAdminOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ProxyAdminOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ProxyAdminOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ProxyAdminOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ProxyAdminOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ProxyAdminOwnershipTransferred represents a OwnershipTransferred event raised by the ProxyAdmin contract.
+type ProxyAdminOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_ProxyAdmin *ProxyAdminFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*ProxyAdminOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _ProxyAdmin.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ProxyAdminOwnershipTransferredIterator{contract: _ProxyAdmin.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_ProxyAdmin *ProxyAdminFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *ProxyAdminOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _ProxyAdmin.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ProxyAdminOwnershipTransferred)
+				if err := _ProxyAdmin.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_ProxyAdmin *ProxyAdminFilterer) ParseOwnershipTransferred(log types.Log) (*ProxyAdminOwnershipTransferred, error) {
+	event := new(ProxyAdminOwnershipTransferred)
+	if err := _ProxyAdmin.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// TransparentUpgradeableProxyABI is the input ABI used to generate the binding from.
+const TransparentUpgradeableProxyABI = "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_logic\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"admin_\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"_data\",\"type\":\"bytes\"}],\"stateMutability\":\"payable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"previousAdmin\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"newAdmin\",\"type\":\"address\"}],\"name\":\"AdminChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"implementation\",\"type\":\"address\"}],\"name\":\"Upgraded\",\"type\":\"event\"},{\"stateMutability\":\"payable\",\"type\":\"fallback\"},{\"inputs\":[],\"name\":\"admin\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"admin_\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newAdmin\",\"type\":\"address\"}],\"name\":\"changeAdmin\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"implementation\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"implementation_\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newImplementation\",\"type\":\"address\"}],\"name\":\"upgradeTo\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newImplementation\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"upgradeToAndCall\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"stateMutability\":\"payable\",\"type\":\"receive\"}]"
+
+// TransparentUpgradeableProxyFuncSigs maps the 4-byte function signature to its string representation.
+var TransparentUpgradeableProxyFuncSigs = map[string]string{
+	"f851a440": "admin()",
+	"8f283970": "changeAdmin(address)",
+	"5c60da1b": "implementation()",
+	"3659cfe6": "upgradeTo(address)",
+	"4f1ef286": "upgradeToAndCall(address,bytes)",
+}
+
+// TransparentUpgradeableProxyBin is the compiled bytecode used for deploying new contracts.
+var TransparentUpgradeableProxyBin = "0x608060405260405162000d0638038062000d06833981016040819052620000269162000330565b82816200005560017f360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbd62000410565b60008051602062000cbf8339815191521462000075576200007562000432565b620000808262000107565b805115620000965762000094828262000189565b505b50620000c6905060017fb53127684a568b3173ae13b9f8a6016e243e63b6e8ee1178d6a717850b5d610462000410565b60008051602062000c9f83398151915214620000e657620000e662000432565b620000fe8260008051602062000c9f83398151915255565b5050506200049b565b803b620001765760405162461bcd60e51b815260206004820152603260248201527f4552433139363750726f78793a206e657720696d706c656d656e746174696f6e604482015271081a5cc81b9bdd08184818dbdb9d1c9858dd60721b60648201526084015b60405180910390fd5b60008051602062000cbf83398151915255565b6060620001b1838360405180606001604052806027815260200162000cdf60279139620001ba565b90505b92915050565b6060833b6200021b5760405162461bcd60e51b815260206004820152602660248201527f416464726573733a2064656c65676174652063616c6c20746f206e6f6e2d636f6044820152651b9d1c9858dd60d21b60648201526084016200016d565b600080856001600160a01b03168560405162000238919062000448565b600060405180830381855af49150503d806000811462000275576040519150601f19603f3d011682016040523d82523d6000602084013e6200027a565b606091505b5090925090506200028d82828662000299565b925050505b9392505050565b60608315620002aa57508162000292565b825115620002bb5782518084602001fd5b8160405162461bcd60e51b81526004016200016d919062000466565b80516001600160a01b0381168114620002ef57600080fd5b919050565b634e487b7160e01b600052604160045260246000fd5b60005b83811015620003275781810151838201526020016200030d565b50506000910152565b6000806000606084860312156200034657600080fd5b6200035184620002d7565b92506200036160208501620002d7565b60408501519092506001600160401b03808211156200037f57600080fd5b818601915086601f8301126200039457600080fd5b815181811115620003a957620003a9620002f4565b604051601f8201601f19908116603f01168101908382118183101715620003d457620003d4620002f4565b81604052828152896020848701011115620003ee57600080fd5b620004018360208301602088016200030a565b80955050505050509250925092565b81810381811115620001b457634e487b7160e01b600052601160045260246000fd5b634e487b7160e01b600052600160045260246000fd5b600082516200045c8184602087016200030a565b9190910192915050565b6020815260008251806020840152620004878160408501602087016200030a565b601f01601f19169190910160400192915050565b6107f480620004ab6000396000f3fe60806040526004361061004e5760003560e01c80633659cfe6146100655780634f1ef286146100855780635c60da1b146100985780638f283970146100c9578063f851a440146100e95761005d565b3661005d5761005b6100fe565b005b61005b6100fe565b34801561007157600080fd5b5061005b610080366004610666565b610138565b61005b610093366004610681565b61016b565b3480156100a457600080fd5b506100ad6101e6565b6040516001600160a01b03909116815260200160405180910390f35b3480156100d557600080fd5b5061005b6100e4366004610666565b61023e565b3480156100f557600080fd5b506100ad61034d565b610106610388565b6101366101317f360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc5490565b610420565b565b600080516020610778833981519152546001600160a01b031633036101635761016081610444565b50565b6101606100fe565b600080516020610778833981519152546001600160a01b031633036101d95761019383610444565b6101d38383838080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525061048492505050565b50505050565b6101e16100fe565b505050565b60006101fe6000805160206107788339815191525490565b6001600160a01b0316330361023357507f360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc5490565b61023b6100fe565b90565b600080516020610778833981519152546001600160a01b03163303610163576001600160a01b0381166102de5760405162461bcd60e51b815260206004820152603a60248201527f5472616e73706172656e745570677261646561626c6550726f78793a206e657760448201527f2061646d696e20697320746865207a65726f206164647265737300000000000060648201526084015b60405180910390fd5b7f7e644d79422f17c01e4894b5f4f588d331ebfa28653d42ae832dc59e38c9798f6103156000805160206107788339815191525490565b604080516001600160a01b03928316815291841660208301520160405180910390a16101608160008051602061077883398151915255565b60006103656000805160206107788339815191525490565b6001600160a01b0316330361023357506000805160206107788339815191525490565b600080516020610778833981519152546001600160a01b031633036101365760405162461bcd60e51b815260206004820152604260248201527f5472616e73706172656e745570677261646561626c6550726f78793a2061646d60448201527f696e2063616e6e6f742066616c6c6261636b20746f2070726f78792074617267606482015261195d60f21b608482015260a4016102d5565b3660008037600080366000845af43d6000803e80801561043f573d6000f35b3d6000fd5b61044d816104b0565b6040516001600160a01b038216907fbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b90600090a250565b60606104a983836040518060600160405280602781526020016107986027913961053d565b9392505050565b803b6105195760405162461bcd60e51b815260206004820152603260248201527f4552433139363750726f78793a206e657720696d706c656d656e746174696f6e604482015271081a5cc81b9bdd08184818dbdb9d1c9858dd60721b60648201526084016102d5565b7f360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc55565b6060833b61059c5760405162461bcd60e51b815260206004820152602660248201527f416464726573733a2064656c65676174652063616c6c20746f206e6f6e2d636f6044820152651b9d1c9858dd60d21b60648201526084016102d5565b600080856001600160a01b0316856040516105b79190610728565b600060405180830381855af49150503d80600081146105f2576040519150601f19603f3d011682016040523d82523d6000602084013e6105f7565b606091505b5091509150610607828286610611565b9695505050505050565b606083156106205750816104a9565b8251156106305782518084602001fd5b8160405162461bcd60e51b81526004016102d59190610744565b80356001600160a01b038116811461066157600080fd5b919050565b60006020828403121561067857600080fd5b6104a98261064a565b60008060006040848603121561069657600080fd5b61069f8461064a565b9250602084013567ffffffffffffffff808211156106bc57600080fd5b818601915086601f8301126106d057600080fd5b8135818111156106df57600080fd5b8760208285010111156106f157600080fd5b6020830194508093505050509250925092565b60005b8381101561071f578181015183820152602001610707565b50506000910152565b6000825161073a818460208701610704565b9190910192915050565b6020815260008251806020840152610763816040850160208701610704565b601f01601f1916919091016040019291505056feb53127684a568b3173ae13b9f8a6016e243e63b6e8ee1178d6a717850b5d6103416464726573733a206c6f772d6c6576656c2064656c65676174652063616c6c206661696c6564a2646970667358221220ee74449bc67c2b81d2bd9a6ff87d348c30d91dd80b18a0f96ebbda05f3170d1864736f6c63430008150033b53127684a568b3173ae13b9f8a6016e243e63b6e8ee1178d6a717850b5d6103360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc416464726573733a206c6f772d6c6576656c2064656c65676174652063616c6c206661696c6564"
+
+// DeployTransparentUpgradeableProxy deploys a new Ethereum contract, binding an instance of TransparentUpgradeableProxy to it.
+func DeployTransparentUpgradeableProxy(auth *bind.TransactOpts, backend bind.ContractBackend, _logic common.Address, admin_ common.Address, _data []byte) (common.Address, *types.Transaction, *TransparentUpgradeableProxy, error) {
+	parsed, err := abi.JSON(strings.NewReader(TransparentUpgradeableProxyABI))
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, parsed, common.FromHex(TransparentUpgradeableProxyBin), backend, _logic, admin_, _data)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &TransparentUpgradeableProxy{TransparentUpgradeableProxyCaller: TransparentUpgradeableProxyCaller{contract: contract}, TransparentUpgradeableProxyTransactor: TransparentUpgradeableProxyTransactor{contract: contract}, TransparentUpgradeableProxyFilterer: TransparentUpgradeableProxyFilterer{contract: contract}}, nil
+}
+
+// TransparentUpgradeableProxy is an auto generated Go binding around an Ethereum contract.
+type TransparentUpgradeableProxy struct {
+	TransparentUpgradeableProxyCaller     // Read-only binding to the contract
+	TransparentUpgradeableProxyTransactor // Write-only binding to the contract
+	TransparentUpgradeableProxyFilterer   // Log filterer for contract events
+}
+
+// TransparentUpgradeableProxyCaller is an auto generated read-only Go binding around an Ethereum contract.
+type TransparentUpgradeableProxyCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// TransparentUpgradeableProxyTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type TransparentUpgradeableProxyTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// TransparentUpgradeableProxyFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type TransparentUpgradeableProxyFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// TransparentUpgradeableProxySession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type TransparentUpgradeableProxySession struct {
+	Contract     *TransparentUpgradeableProxy // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts                // Call options to use throughout this session
+	TransactOpts bind.TransactOpts            // Transaction auth options to use throughout this session
+}
+
+// TransparentUpgradeableProxyCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type TransparentUpgradeableProxyCallerSession struct {
+	Contract *TransparentUpgradeableProxyCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                      // Call options to use throughout this session
+}
+
+// TransparentUpgradeableProxyTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type TransparentUpgradeableProxyTransactorSession struct {
+	Contract     *TransparentUpgradeableProxyTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                      // Transaction auth options to use throughout this session
+}
+
+// TransparentUpgradeableProxyRaw is an auto generated low-level Go binding around an Ethereum contract.
+type TransparentUpgradeableProxyRaw struct {
+	Contract *TransparentUpgradeableProxy // Generic contract binding to access the raw methods on
+}
+
+// TransparentUpgradeableProxyCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type TransparentUpgradeableProxyCallerRaw struct {
+	Contract *TransparentUpgradeableProxyCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// TransparentUpgradeableProxyTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type TransparentUpgradeableProxyTransactorRaw struct {
+	Contract *TransparentUpgradeableProxyTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewTransparentUpgradeableProxy creates a new instance of TransparentUpgradeableProxy, bound to a specific deployed contract.
+func NewTransparentUpgradeableProxy(address common.Address, backend bind.ContractBackend) (*TransparentUpgradeableProxy, error) {
+	contract, err := bindTransparentUpgradeableProxy(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &TransparentUpgradeableProxy{TransparentUpgradeableProxyCaller: TransparentUpgradeableProxyCaller{contract: contract}, TransparentUpgradeableProxyTransactor: TransparentUpgradeableProxyTransactor{contract: contract}, TransparentUpgradeableProxyFilterer: TransparentUpgradeableProxyFilterer{contract: contract}}, nil
+}
+
+// NewTransparentUpgradeableProxyCaller creates a new read-only instance of TransparentUpgradeableProxy, bound to a specific deployed contract.
+func NewTransparentUpgradeableProxyCaller(address common.Address, caller bind.ContractCaller) (*TransparentUpgradeableProxyCaller, error) {
+	contract, err := bindTransparentUpgradeableProxy(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &TransparentUpgradeableProxyCaller{contract: contract}, nil
+}
+
+// NewTransparentUpgradeableProxyTransactor creates a new write-only instance of TransparentUpgradeableProxy, bound to a specific deployed contract.
+func NewTransparentUpgradeableProxyTransactor(address common.Address, transactor bind.ContractTransactor) (*TransparentUpgradeableProxyTransactor, error) {
+	contract, err := bindTransparentUpgradeableProxy(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &TransparentUpgradeableProxyTransactor{contract: contract}, nil
+}
+
+// NewTransparentUpgradeableProxyFilterer creates a new log filterer instance of TransparentUpgradeableProxy, bound to a specific deployed contract.
+func NewTransparentUpgradeableProxyFilterer(address common.Address, filterer bind.ContractFilterer) (*TransparentUpgradeableProxyFilterer, error) {
+	contract, err := bindTransparentUpgradeableProxy(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &TransparentUpgradeableProxyFilterer{contract: contract}, nil
+}
+
+// bindTransparentUpgradeableProxy binds a generic wrapper to an already deployed contract.
+func bindTransparentUpgradeableProxy(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(TransparentUpgradeableProxyABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_TransparentUpgradeableProxy *TransparentUpgradeableProxyRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _TransparentUpgradeableProxy.Contract.TransparentUpgradeableProxyCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_TransparentUpgradeableProxy *TransparentUpgradeableProxyRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _TransparentUpgradeableProxy.Contract.TransparentUpgradeableProxyTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_TransparentUpgradeableProxy *TransparentUpgradeableProxyRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _TransparentUpgradeableProxy.Contract.TransparentUpgradeableProxyTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_TransparentUpgradeableProxy *TransparentUpgradeableProxyCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _TransparentUpgradeableProxy.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_TransparentUpgradeableProxy *TransparentUpgradeableProxyTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _TransparentUpgradeableProxy.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_TransparentUpgradeableProxy *TransparentUpgradeableProxyTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _TransparentUpgradeableProxy.Contract.contract.Transact(opts, method, params...)
+}
+
+// Admin is a paid mutator transaction binding the contract method 0xf851a440.
+//
+// Solidity: function admin() returns(address admin_)
+func (_TransparentUpgradeableProxy *TransparentUpgradeableProxyTransactor) Admin(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _TransparentUpgradeableProxy.contract.Transact(opts, "admin")
+}
+
+// Admin is a paid mutator transaction binding the contract method 0xf851a440.
+//
+// Solidity: function admin() returns(address admin_)
+func (_TransparentUpgradeableProxy *TransparentUpgradeableProxySession) Admin() (*types.Transaction, error) {
+	return _TransparentUpgradeableProxy.Contract.Admin(&_TransparentUpgradeableProxy.TransactOpts)
+}
+
+// Admin is a paid mutator transaction binding the contract method 0xf851a440.
+//
+// Solidity: function admin() returns(address admin_)
+func (_TransparentUpgradeableProxy *TransparentUpgradeableProxyTransactorSession) Admin() (*types.Transaction, error) {
+	return _TransparentUpgradeableProxy.Contract.Admin(&_TransparentUpgradeableProxy.TransactOpts)
+}
+
+// ChangeAdmin is a paid mutator transaction binding the contract method 0x8f283970.
+//
+// Solidity: function changeAdmin(address newAdmin) returns()
+func (_TransparentUpgradeableProxy *TransparentUpgradeableProxyTransactor) ChangeAdmin(opts *bind.TransactOpts, newAdmin common.Address) (*types.Transaction, error) {
+	return _TransparentUpgradeableProxy.contract.Transact(opts, "changeAdmin", newAdmin)
+}
+
+// ChangeAdmin is a paid mutator transaction binding the contract method 0x8f283970.
+//
+// Solidity: function changeAdmin(address newAdmin) returns()
+func (_TransparentUpgradeableProxy *TransparentUpgradeableProxySession) ChangeAdmin(newAdmin common.Address) (*types.Transaction, error) {
+	return _TransparentUpgradeableProxy.Contract.ChangeAdmin(&_TransparentUpgradeableProxy.TransactOpts, newAdmin)
+}
+
+// ChangeAdmin is a paid mutator transaction binding the contract method 0x8f283970.
+//
+// Solidity: function changeAdmin(address newAdmin) returns()
+func (_TransparentUpgradeableProxy *TransparentUpgradeableProxyTransactorSession) ChangeAdmin(newAdmin common.Address) (*types.Transaction, error) {
+	return _TransparentUpgradeableProxy.Contract.ChangeAdmin(&_TransparentUpgradeableProxy.TransactOpts, newAdmin)
+}
+
+// Implementation is a paid mutator transaction binding the contract method 0x5c60da1b.
+//
+// Solidity: function implementation() returns(address implementation_)
+func (_TransparentUpgradeableProxy *TransparentUpgradeableProxyTransactor) Implementation(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _TransparentUpgradeableProxy.contract.Transact(opts, "implementation")
+}
+
+// Implementation is a paid mutator transaction binding the contract method 0x5c60da1b.
+//
+// Solidity: function implementation() returns(address implementation_)
+func (_TransparentUpgradeableProxy *TransparentUpgradeableProxySession) Implementation() (*types.Transaction, error) {
+	return _TransparentUpgradeableProxy.Contract.Implementation(&_TransparentUpgradeableProxy.TransactOpts)
+}
+
+// Implementation is a paid mutator transaction binding the contract method 0x5c60da1b.
+//
+// Solidity: function implementation() returns(address implementation_)
+func (_TransparentUpgradeableProxy *TransparentUpgradeableProxyTransactorSession) Implementation() (*types.Transaction, error) {
+	return _TransparentUpgradeableProxy.Contract.Implementation(&_TransparentUpgradeableProxy.TransactOpts)
+}
+
+// UpgradeTo is a paid mutator transaction binding the contract method 0x3659cfe6.
+//
+// Solidity: function upgradeTo(address newImplementation) returns()
+func (_TransparentUpgradeableProxy *TransparentUpgradeableProxyTransactor) UpgradeTo(opts *bind.TransactOpts, newImplementation common.Address) (*types.Transaction, error) {
+	return _TransparentUpgradeableProxy.contract.Transact(opts, "upgradeTo", newImplementation)
+}
+
+// UpgradeTo is a paid mutator transaction binding the contract method 0x3659cfe6.
+//
+// Solidity: function upgradeTo(address newImplementation) returns()
+func (_TransparentUpgradeableProxy *TransparentUpgradeableProxySession) UpgradeTo(newImplementation common.Address) (*types.Transaction, error) {
+	return _TransparentUpgradeableProxy.Contract.UpgradeTo(&_TransparentUpgradeableProxy.TransactOpts, newImplementation)
+}
+
+// UpgradeTo is a paid mutator transaction binding the contract method 0x3659cfe6.
+//
+// Solidity: function upgradeTo(address newImplementation) returns()
+func (_TransparentUpgradeableProxy *TransparentUpgradeableProxyTransactorSession) UpgradeTo(newImplementation common.Address) (*types.Transaction, error) {
+	return _TransparentUpgradeableProxy.Contract.UpgradeTo(&_TransparentUpgradeableProxy.TransactOpts, newImplementation)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_TransparentUpgradeableProxy *TransparentUpgradeableProxyTransactor) UpgradeToAndCall(opts *bind.TransactOpts, newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _TransparentUpgradeableProxy.contract.Transact(opts, "upgradeToAndCall", newImplementation, data)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_TransparentUpgradeableProxy *TransparentUpgradeableProxySession) UpgradeToAndCall(newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _TransparentUpgradeableProxy.Contract.UpgradeToAndCall(&_TransparentUpgradeableProxy.TransactOpts, newImplementation, data)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_TransparentUpgradeableProxy *TransparentUpgradeableProxyTransactorSession) UpgradeToAndCall(newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _TransparentUpgradeableProxy.Contract.UpgradeToAndCall(&_TransparentUpgradeableProxy.TransactOpts, newImplementation, data)
+}
+
+// Fallback is a paid mutator transaction binding the contract fallback function.
+//
+// Solidity: fallback() payable returns()
+func (_TransparentUpgradeableProxy *TransparentUpgradeableProxyTransactor) Fallback(opts *bind.TransactOpts, calldata []byte) (*types.Transaction, error) {
+	return _TransparentUpgradeableProxy.contract.RawTransact(opts, calldata)
+}
+
+// Fallback is a paid mutator transaction binding the contract fallback function.
+//
+// Solidity: fallback() payable returns()
+func (_TransparentUpgradeableProxy *TransparentUpgradeableProxySession) Fallback(calldata []byte) (*types.Transaction, error) {
+	return _TransparentUpgradeableProxy.Contract.Fallback(&_TransparentUpgradeableProxy.TransactOpts, calldata)
+}
+
+// Fallback is a paid mutator transaction binding the contract fallback function.
+//
+// Solidity: fallback() payable returns()
+func (_TransparentUpgradeableProxy *TransparentUpgradeableProxyTransactorSession) Fallback(calldata []byte) (*types.Transaction, error) {
+	return _TransparentUpgradeableProxy.Contract.Fallback(&_TransparentUpgradeableProxy.TransactOpts, calldata)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_TransparentUpgradeableProxy *TransparentUpgradeableProxyTransactor) Receive(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _TransparentUpgradeableProxy.contract.RawTransact(opts, nil) // calldata is disallowed for receive function
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_TransparentUpgradeableProxy *TransparentUpgradeableProxySession) Receive() (*types.Transaction, error) {
+	return _TransparentUpgradeableProxy.Contract.Receive(&_TransparentUpgradeableProxy.TransactOpts)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_TransparentUpgradeableProxy *TransparentUpgradeableProxyTransactorSession) Receive() (*types.Transaction, error) {
+	return _TransparentUpgradeableProxy.Contract.Receive(&_TransparentUpgradeableProxy.TransactOpts)
+}
+
+// TransparentUpgradeableProxyAdminChangedIterator is returned from FilterAdminChanged and is used to iterate over the raw logs and unpacked data for AdminChanged events raised by the TransparentUpgradeableProxy contract.
+type TransparentUpgradeableProxyAdminChangedIterator struct {
+	Event *TransparentUpgradeableProxyAdminChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *TransparentUpgradeableProxyAdminChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(TransparentUpgradeableProxyAdminChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(TransparentUpgradeableProxyAdminChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *TransparentUpgradeableProxyAdminChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *TransparentUpgradeableProxyAdminChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// TransparentUpgradeableProxyAdminChanged represents a AdminChanged event raised by the TransparentUpgradeableProxy contract.
+type TransparentUpgradeableProxyAdminChanged struct {
+	PreviousAdmin common.Address
+	NewAdmin      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterAdminChanged is a free log retrieval operation binding the contract event 0x7e644d79422f17c01e4894b5f4f588d331ebfa28653d42ae832dc59e38c9798f.
+//
+// Solidity: event AdminChanged(address previousAdmin, address newAdmin)
+func (_TransparentUpgradeableProxy *TransparentUpgradeableProxyFilterer) FilterAdminChanged(opts *bind.FilterOpts) (*TransparentUpgradeableProxyAdminChangedIterator, error) {
+
+	logs, sub, err := _TransparentUpgradeableProxy.contract.FilterLogs(opts, "AdminChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &TransparentUpgradeableProxyAdminChangedIterator{contract: _TransparentUpgradeableProxy.contract, event: "AdminChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchAdminChanged is a free log subscription operation binding the contract event 0x7e644d79422f17c01e4894b5f4f588d331ebfa28653d42ae832dc59e38c9798f.
+//
+// Solidity: event AdminChanged(address previousAdmin, address newAdmin)
+func (_TransparentUpgradeableProxy *TransparentUpgradeableProxyFilterer) WatchAdminChanged(opts *bind.WatchOpts, sink chan<- *TransparentUpgradeableProxyAdminChanged) (event.Subscription, error) {
+
+	logs, sub, err := _TransparentUpgradeableProxy.contract.WatchLogs(opts, "AdminChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(TransparentUpgradeableProxyAdminChanged)
+				if err := _TransparentUpgradeableProxy.contract.UnpackLog(event, "AdminChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseAdminChanged is a log parse operation binding the contract event 0x7e644d79422f17c01e4894b5f4f588d331ebfa28653d42ae832dc59e38c9798f.
+//
+// Solidity: event AdminChanged(address previousAdmin, address newAdmin)
+func (_TransparentUpgradeableProxy *TransparentUpgradeableProxyFilterer) ParseAdminChanged(log types.Log) (*TransparentUpgradeableProxyAdminChanged, error) {
+	event := new(TransparentUpgradeableProxyAdminChanged)
+	if err := _TransparentUpgradeableProxy.contract.UnpackLog(event, "AdminChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// TransparentUpgradeableProxyUpgradedIterator is returned from FilterUpgraded and is used to iterate over the raw logs and unpacked data for Upgraded events raised by the TransparentUpgradeableProxy contract.
+type TransparentUpgradeableProxyUpgradedIterator struct {
+	Event *TransparentUpgradeableProxyUpgraded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *TransparentUpgradeableProxyUpgradedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(TransparentUpgradeableProxyUpgraded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(TransparentUpgradeableProxyUpgraded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *TransparentUpgradeableProxyUpgradedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *TransparentUpgradeableProxyUpgradedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// TransparentUpgradeableProxyUpgraded represents a Upgraded event raised by the TransparentUpgradeableProxy contract.
+type TransparentUpgradeableProxyUpgraded struct {
+	Implementation common.Address
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterUpgraded is a free log retrieval operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_TransparentUpgradeableProxy *TransparentUpgradeableProxyFilterer) FilterUpgraded(opts *bind.FilterOpts, implementation []common.Address) (*TransparentUpgradeableProxyUpgradedIterator, error) {
+
+	var implementationRule []interface{}
+	for _, implementationItem := range implementation {
+		implementationRule = append(implementationRule, implementationItem)
+	}
+
+	logs, sub, err := _TransparentUpgradeableProxy.contract.FilterLogs(opts, "Upgraded", implementationRule)
+	if err != nil {
+		return nil, err
+	}
+	return &TransparentUpgradeableProxyUpgradedIterator{contract: _TransparentUpgradeableProxy.contract, event: "Upgraded", logs: logs, sub: sub}, nil
+}
+
+// WatchUpgraded is a free log subscription operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_TransparentUpgradeableProxy *TransparentUpgradeableProxyFilterer) WatchUpgraded(opts *bind.WatchOpts, sink chan<- *TransparentUpgradeableProxyUpgraded, implementation []common.Address) (event.Subscription, error) {
+
+	var implementationRule []interface{}
+	for _, implementationItem := range implementation {
+		implementationRule = append(implementationRule, implementationItem)
+	}
+
+	logs, sub, err := _TransparentUpgradeableProxy.contract.WatchLogs(opts, "Upgraded", implementationRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(TransparentUpgradeableProxyUpgraded)
+				if err := _TransparentUpgradeableProxy.contract.UnpackLog(event, "Upgraded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUpgraded is a log parse operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_TransparentUpgradeableProxy *TransparentUpgradeableProxyFilterer) ParseUpgraded(log types.Log) (*TransparentUpgradeableProxyUpgraded, error) {
+	event := new(TransparentUpgradeableProxyUpgraded)
+	if err := _TransparentUpgradeableProxy.contract.UnpackLog(event, "Upgraded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}